@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/fx"
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/http/handler"
 	internalMiddleware "github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/http/middleware"
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres"
@@ -69,7 +71,7 @@ func main() {
 		Addr: redisHost + ":6379",
 	})
 	if err := redisClient.Ping(ctx).Err(); err != nil {
-		log.Warn().Err(err).Msg("Não foi possível conectar ao Redis (Idempotência desabilitada)")
+		log.Warn().Err(err).Msg("Não foi possível conectar ao Redis (Idempotência segue pelo Postgres, sem cache)")
 	} else {
 		log.Info().Msg("✅ Conectado ao Redis!")
 	}
@@ -129,20 +131,139 @@ func main() {
 	}
 
 	// Inicialização da Camada de Infraestrutura (Repositories)
-	idempotencyRepo := redisInfra.NewIdempotencyRepository(redisClient)
+	// Idempotência: Postgres é a fonte da verdade, Redis é cache de leitura
+	idempotencyRepository := redisInfra.NewCachedIdempotencyRepository(
+		redisInfra.NewIdempotencyRepository(redisClient),
+		postgres.NewIdempotencyRepository(dbPool),
+	)
 	walletRepository := postgres.NewWalletRepository(dbPool)
 	transactionRepository := postgres.NewTransactionRepository(dbPool)
+	entryRepository := postgres.NewEntryRepository(dbPool)
+	fxQuoteRepository := postgres.NewFXQuoteRepository(dbPool)
+	limitRepository := postgres.NewLimitRepository(dbPool)
+	feePolicyRepository := postgres.NewFeePolicyRepository(dbPool)
+	holdRepository := postgres.NewHoldRepository(dbPool)
+	scheduledTransferRepository := postgres.NewScheduledTransferRepository(dbPool)
+	standingOrderRepository := postgres.NewStandingOrderRepository(dbPool)
+	walletLimitChangeRepository := postgres.NewWalletLimitChangeRepository(dbPool)
+	interestRepository := postgres.NewInterestRepository(dbPool)
+	customerRepository := postgres.NewCustomerRepository(dbPool)
+	balanceSnapshotRepository := postgres.NewBalanceSnapshotRepository(dbPool)
 	//  Unit of Work (Gerenciador de Transações)
 	uow := postgres.NewUow(dbPool)
 
+	// Câmbio: tabela estática para ambiente local (trocar por um provider de mercado em produção)
+	fxRateProvider, err := fx.NewStaticRateProvider(fx.DefaultRates)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Tabela de câmbio inválida")
+	}
+	fxQuoteTTL := 30 * time.Second
+	if v := os.Getenv("FX_QUOTE_TTL"); v != "" {
+		if fxQuoteTTL, err = time.ParseDuration(v); err != nil {
+			log.Fatal().Err(err).Msg("FX_QUOTE_TTL inválido")
+		}
+	}
+
 	// Inicialização da Camada de UseCase (Regras de Negócio)
-	transferUseCase := usecase.NewTransferMoney(walletRepository, transactionRepository, uow, eventPublisher)
-	createWalletUseCase := usecase.NewCreateWallet(walletRepository)
+	transferUseCase := usecase.NewTransferMoney(walletRepository, transactionRepository, entryRepository, fxQuoteRepository, limitRepository, feePolicyRepository, uow, eventPublisher)
+	createFXQuoteUseCase := usecase.NewCreateFXQuote(fxRateProvider, fxQuoteRepository, uow, fxQuoteTTL)
+	createWalletUseCase := usecase.NewCreateWallet(walletRepository, uow)
+	depositUseCase := usecase.NewDeposit(walletRepository, transactionRepository, entryRepository, uow, eventPublisher)
+	withdrawUseCase := usecase.NewWithdraw(walletRepository, transactionRepository, entryRepository, limitRepository, uow, eventPublisher)
 	getWalletUseCase := usecase.NewGetWallet(walletRepository)
+	reconcileWalletUseCase := usecase.NewReconcileWallet(walletRepository, entryRepository, uow)
+	// As fotografias de saldo de fim de dia são gravadas pelo cmd/snapshot
+	getWalletBalanceUseCase := usecase.NewGetWalletBalance(walletRepository, entryRepository, balanceSnapshotRepository)
+	getBalanceHistoryUseCase := usecase.NewGetBalanceHistory(walletRepository, entryRepository, balanceSnapshotRepository)
+	generateStatementUseCase := usecase.NewGenerateStatement(walletRepository, transactionRepository, entryRepository, balanceSnapshotRepository)
+	listTransactionsUseCase := usecase.NewListTransactions(walletRepository, transactionRepository)
+	getTransactionUseCase := usecase.NewGetTransaction(transactionRepository, entryRepository)
+	createHoldUseCase := usecase.NewCreateHold(walletRepository, holdRepository, uow, eventPublisher)
+	getHoldUseCase := usecase.NewGetHold(holdRepository)
+	captureHoldUseCase := usecase.NewCaptureHold(walletRepository, holdRepository, transferUseCase, uow, eventPublisher)
+	voidHoldUseCase := usecase.NewVoidHold(walletRepository, holdRepository, uow, eventPublisher)
+	postJournalEntryUseCase := usecase.NewPostJournalEntry(walletRepository, transactionRepository, entryRepository, limitRepository, uow, eventPublisher)
+	transferBatchUseCase := usecase.NewTransferBatch(walletRepository, transferUseCase, uow, eventPublisher)
+	refundTransferUseCase := usecase.NewRefundTransfer(transactionRepository, transferUseCase, uow, eventPublisher)
+	// A execução dos agendamentos e das ordens permanentes (e a expiração das autorizações) roda no cmd/scheduler
+	createScheduledTransferUseCase := usecase.NewCreateScheduledTransfer(walletRepository, scheduledTransferRepository, uow)
+	getScheduledTransferUseCase := usecase.NewGetScheduledTransfer(scheduledTransferRepository)
+	listScheduledTransfersUseCase := usecase.NewListScheduledTransfers(walletRepository, scheduledTransferRepository)
+	cancelScheduledTransferUseCase := usecase.NewCancelScheduledTransfer(scheduledTransferRepository, uow)
+	createStandingOrderUseCase := usecase.NewCreateStandingOrder(walletRepository, standingOrderRepository, uow)
+	getStandingOrderUseCase := usecase.NewGetStandingOrder(standingOrderRepository)
+	listStandingOrdersUseCase := usecase.NewListStandingOrders(walletRepository, standingOrderRepository)
+	updateStandingOrderUseCase := usecase.NewUpdateStandingOrder(standingOrderRepository, uow)
+	cancelStandingOrderUseCase := usecase.NewCancelStandingOrder(standingOrderRepository, uow)
+	updateOverdraftLimitUseCase := usecase.NewUpdateOverdraftLimit(walletRepository, walletLimitChangeRepository, uow)
+	listOverdraftLimitChangesUseCase := usecase.NewListOverdraftLimitChanges(walletRepository, walletLimitChangeRepository)
+	changeWalletStatusUseCase := usecase.NewChangeWalletStatus(walletRepository, uow, eventPublisher)
+	getWalletLimitsUseCase := usecase.NewGetWalletLimits(walletRepository, limitRepository)
+	updateWalletLimitsUseCase := usecase.NewUpdateWalletLimits(walletRepository, limitRepository, uow)
+	listLimitTiersUseCase := usecase.NewListLimitTiers(limitRepository)
+	saveLimitTierUseCase := usecase.NewSaveLimitTier(limitRepository, uow)
+	listFeePoliciesUseCase := usecase.NewListFeePolicies(feePolicyRepository)
+	saveFeePolicyUseCase := usecase.NewSaveFeePolicy(walletRepository, limitRepository, feePolicyRepository, uow)
+	deleteFeePolicyUseCase := usecase.NewDeleteFeePolicy(feePolicyRepository, uow)
+	// O rendimento diário e o pagamento mensal dos juros rodam no cmd/interest
+	getWalletInterestUseCase := usecase.NewGetWalletInterest(interestRepository)
+	updateWalletInterestUseCase := usecase.NewUpdateWalletInterest(walletRepository, interestRepository, uow)
+	createCustomerUseCase := usecase.NewCreateCustomer(customerRepository, uow)
+	getCustomerUseCase := usecase.NewGetCustomer(customerRepository)
+	listCustomersUseCase := usecase.NewListCustomers(customerRepository)
+	updateCustomerUseCase := usecase.NewUpdateCustomer(customerRepository, uow)
+	deleteCustomerUseCase := usecase.NewDeleteCustomer(customerRepository, uow)
+	listCustomerWalletsUseCase := usecase.NewListCustomerWallets(customerRepository, walletRepository)
+	listIdempotencyKeysUseCase := usecase.NewListIdempotencyKeys(idempotencyRepository)
+	purgeIdempotencyKeysUseCase := usecase.NewPurgeIdempotencyKeys(idempotencyRepository)
 
 	// Handlers
 	transferHandler := handler.NewTransferHandler(transferUseCase)
-	walletHandler := handler.NewWalletHandler(createWalletUseCase, getWalletUseCase)
+	transferBatchHandler := handler.NewTransferBatchHandler(transferBatchUseCase)
+	journalEntryHandler := handler.NewJournalEntryHandler(postJournalEntryUseCase)
+	walletHandler := handler.NewWalletHandler(createWalletUseCase, getWalletUseCase, reconcileWalletUseCase)
+	cashHandler := handler.NewCashHandler(depositUseCase, withdrawUseCase)
+	balanceHandler := handler.NewBalanceHandler(getWalletBalanceUseCase, getBalanceHistoryUseCase)
+	statementHandler := handler.NewStatementHandler(generateStatementUseCase)
+	fxHandler := handler.NewFXHandler(createFXQuoteUseCase)
+	transactionHandler := handler.NewTransactionHandler(listTransactionsUseCase, getTransactionUseCase)
+	refundHandler := handler.NewRefundHandler(refundTransferUseCase)
+	scheduledTransferHandler := handler.NewScheduledTransferHandler(
+		createScheduledTransferUseCase,
+		getScheduledTransferUseCase,
+		listScheduledTransfersUseCase,
+		cancelScheduledTransferUseCase,
+	)
+	standingOrderHandler := handler.NewStandingOrderHandler(
+		createStandingOrderUseCase,
+		getStandingOrderUseCase,
+		listStandingOrdersUseCase,
+		updateStandingOrderUseCase,
+		cancelStandingOrderUseCase,
+	)
+	holdHandler := handler.NewHoldHandler(createHoldUseCase, getHoldUseCase, captureHoldUseCase, voidHoldUseCase)
+	limitHandler := handler.NewLimitHandler(
+		getWalletLimitsUseCase,
+		updateWalletLimitsUseCase,
+		listLimitTiersUseCase,
+		saveLimitTierUseCase,
+	)
+	feePolicyHandler := handler.NewFeePolicyHandler(listFeePoliciesUseCase, saveFeePolicyUseCase, deleteFeePolicyUseCase)
+	interestHandler := handler.NewInterestHandler(getWalletInterestUseCase, updateWalletInterestUseCase)
+	customerHandler := handler.NewCustomerHandler(
+		createCustomerUseCase,
+		getCustomerUseCase,
+		listCustomersUseCase,
+		updateCustomerUseCase,
+		deleteCustomerUseCase,
+		listCustomerWalletsUseCase,
+	)
+	adminWalletHandler := handler.NewAdminWalletHandler(
+		updateOverdraftLimitUseCase,
+		listOverdraftLimitChangesUseCase,
+		changeWalletStatusUseCase,
+	)
+	idempotencyHandler := handler.NewIdempotencyHandler(listIdempotencyKeysUseCase, purgeIdempotencyKeysUseCase)
 
 	// Configuração do Servidor HTTP (Router Chi)
 	router := chi.NewRouter()
@@ -151,7 +272,55 @@ func main() {
 	router.Use(middleware.Logger)
 	router.Use(middleware.Recoverer) // Evita crash se der panic
 	router.Use(middleware.Timeout(60 * time.Second))
-	idempotencyMiddleware := internalMiddleware.Idempotency(idempotencyRepo)
+	// IDEMPOTENCY_WAIT: quanto uma requisição duplicada espera pela primeira antes do 409 (padrão: não espera)
+	idempotencyConfig := internalMiddleware.IdempotencyConfig{}
+	if v := os.Getenv("IDEMPOTENCY_WAIT"); v != "" {
+		if idempotencyConfig.Wait, err = time.ParseDuration(v); err != nil {
+			log.Fatal().Err(err).Msg("IDEMPOTENCY_WAIT inválido")
+		}
+	}
+	if v := os.Getenv("IDEMPOTENCY_LEASE"); v != "" {
+		if idempotencyConfig.Lease, err = time.ParseDuration(v); err != nil {
+			log.Fatal().Err(err).Msg("IDEMPOTENCY_LEASE inválido")
+		}
+	}
+	// IDEMPOTENCY_ROUTE_TTL: TTL por rota, ex: "POST /transfers=48h,POST /admin/wallets/{id}/deposits=72h"
+	if v := os.Getenv("IDEMPOTENCY_ROUTE_TTL"); v != "" {
+		idempotencyConfig.RouteTTL = map[string]time.Duration{}
+		for _, item := range strings.Split(v, ",") {
+			route, value, _ := strings.Cut(strings.TrimSpace(item), "=")
+			ttl, err := time.ParseDuration(value)
+			if err != nil || ttl <= 0 {
+				log.Fatal().Str("value", item).Msg("IDEMPOTENCY_ROUTE_TTL inválido")
+			}
+			idempotencyConfig.RouteTTL[route] = ttl
+		}
+	}
+	// IDEMPOTENCY_FAIL_MODE: "open" (padrão) processa sem proteção se o armazenamento cair; "closed" responde 503
+	switch v := os.Getenv("IDEMPOTENCY_FAIL_MODE"); v {
+	case "", "open":
+	case "closed":
+		idempotencyConfig.FailClosed = true
+	default:
+		log.Fatal().Str("value", v).Msg("IDEMPOTENCY_FAIL_MODE inválido (use open ou closed)")
+	}
+	// IDEMPOTENCY_REQUIRED_ROUTES: rotas que exigem Idempotency-Key (400 sem ela), ex: "POST /transfers,POST /wallets"
+	if v := os.Getenv("IDEMPOTENCY_REQUIRED_ROUTES"); v != "" {
+		idempotencyConfig.RequiredRoutes = map[string]bool{}
+		for _, route := range strings.Split(v, ",") {
+			idempotencyConfig.RequiredRoutes[strings.TrimSpace(route)] = true
+		}
+	}
+	// O expurgo mexe nas próprias chaves de idempotência: sob uma Idempotency-Key, apagaria a reserva dele mesmo
+	idempotencyConfig.SkipRoutes = map[string]bool{"DELETE /admin/idempotency-keys": true}
+	// Idempotência em todas as rotas que alteram estado (GET/HEAD/OPTIONS passam direto).
+	// Montada em cada grupo depois da autenticação: requisição recusada não reserva chave nem abre transação.
+	idempotencyMiddleware := internalMiddleware.Idempotency(idempotencyRepository, uow, idempotencyConfig)
+	// Sem ADMIN_TOKEN as rotas /admin respondem 503
+	adminAuthMiddleware := internalMiddleware.AdminAuth(os.Getenv("ADMIN_TOKEN"))
+	if os.Getenv("ADMIN_TOKEN") == "" {
+		log.Warn().Msg("ADMIN_TOKEN não configurado (rotas administrativas desabilitadas)")
+	}
 
 	// Rota de Health Check (para o Docker saber se estamos vivos)
 	router.Get("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -165,9 +334,64 @@ func main() {
 	router.Group(func(r chi.Router) {
 		r.Use(idempotencyMiddleware)
 		r.Post("/transfers", transferHandler.Create)
+		r.Post("/transfer-batches", transferBatchHandler.Create)
+		r.Post("/journal-entries", journalEntryHandler.Create)
+		r.Post("/holds/{id}/capture", holdHandler.Capture)
+		r.Post("/transactions/{id}/refunds", refundHandler.Create)
+		r.Post("/holds", holdHandler.Create)
+		r.Get("/holds/{id}", holdHandler.Get)
+		r.Post("/holds/{id}/void", holdHandler.Void)
+		r.Post("/scheduled-transfers", scheduledTransferHandler.Create)
+		r.Get("/scheduled-transfers", scheduledTransferHandler.List)
+		r.Get("/scheduled-transfers/{id}", scheduledTransferHandler.Get)
+		r.Post("/scheduled-transfers/{id}/cancel", scheduledTransferHandler.Cancel)
+		r.Post("/standing-orders", standingOrderHandler.Create)
+		r.Get("/standing-orders", standingOrderHandler.List)
+		r.Get("/standing-orders/{id}", standingOrderHandler.Get)
+		r.Patch("/standing-orders/{id}", standingOrderHandler.Update)
+		r.Delete("/standing-orders/{id}", standingOrderHandler.Cancel)
+		r.Post("/fx/quotes", fxHandler.CreateQuote)
+		r.Post("/customers", customerHandler.Create)
+		r.Get("/customers", customerHandler.List)
+		r.Get("/customers/{id}", customerHandler.Get)
+		r.Patch("/customers/{id}", customerHandler.Update)
+		r.Delete("/customers/{id}", customerHandler.Delete)
+		r.Get("/customers/{id}/wallets", customerHandler.ListWallets)
+		r.Post("/wallets", walletHandler.Create)
+		r.Get("/wallets/{id}", walletHandler.Get)
+		r.Get("/wallets/{id}/reconciliation", walletHandler.Reconcile)
+		r.Get("/wallets/{id}/balance", balanceHandler.GetBalance)
+		r.Get("/wallets/{id}/balance-history", balanceHandler.GetHistory)
+		r.Get("/wallets/{id}/statements", statementHandler.Get)
+		r.Get("/wallets/{id}/limits", limitHandler.GetWalletLimits)
+		r.Get("/wallets/{id}/interest", interestHandler.GetWalletInterest)
+		r.Get("/wallets/{id}/transactions", transactionHandler.ListByWallet)
+		r.Get("/transactions", transactionHandler.GetByIdempotencyKey)
+		r.Get("/transactions/{id}", transactionHandler.Get)
+	})
+
+	// Rotas administrativas
+	router.Route("/admin", func(r chi.Router) {
+		r.Use(adminAuthMiddleware, idempotencyMiddleware)
+		// Depósitos e saques movem dinheiro de/para fora da instituição: só a integração de pagamentos chama
+		r.Post("/wallets/{id}/deposits", cashHandler.Deposit)
+		r.Post("/wallets/{id}/withdrawals", cashHandler.Withdraw)
+		r.Put("/wallets/{id}/overdraft-limit", adminWalletHandler.UpdateOverdraftLimit)
+		r.Get("/wallets/{id}/overdraft-limit", adminWalletHandler.ListOverdraftLimitChanges)
+		r.Post("/wallets/{id}/freeze", adminWalletHandler.Freeze)
+		r.Post("/wallets/{id}/unfreeze", adminWalletHandler.Unfreeze)
+		r.Post("/wallets/{id}/close", adminWalletHandler.Close)
+		r.Put("/wallets/{id}/limits", limitHandler.UpdateWalletLimits)
+		r.Put("/wallets/{id}/interest", interestHandler.UpdateWalletInterest)
+		r.Put("/customers/{id}/kyc", customerHandler.UpdateKYC)
+		r.Get("/limit-tiers", limitHandler.ListTiers)
+		r.Put("/limit-tiers/{code}", limitHandler.SaveTier)
+		r.Get("/fee-policies", feePolicyHandler.List)
+		r.Put("/fee-policies/{tier}/{currency}", feePolicyHandler.Save)
+		r.Delete("/fee-policies/{tier}/{currency}", feePolicyHandler.Delete)
+		r.Get("/idempotency-keys", idempotencyHandler.List)
+		r.Delete("/idempotency-keys", idempotencyHandler.Purge)
 	})
-	router.Post("/wallets", walletHandler.Create)
-	router.Get("/wallets/{id}", walletHandler.Get)
 
 	// 6. Subir o Servidor
 	port := ":8080"
@@ -22,7 +22,30 @@ type TransactionEvent struct {
 	FromWallet    int64  `json:"from_wallet"`
 	ToWallet      int64  `json:"to_wallet"`
 	Amount        int64  `json:"amount"`
+	FeeAmount     int64  `json:"fee_amount"`
+	Currency      string `json:"currency"`
 	Status        string `json:"status"`
+
+	// Presentes apenas em transferências com câmbio
+	DestinationAmount   int64  `json:"destination_amount,omitempty"`
+	DestinationCurrency string `json:"destination_currency,omitempty"`
+	FXRate              string `json:"fx_rate,omitempty"`
+	FXQuoteID           string `json:"fx_quote_id,omitempty"`
+
+	// Presente apenas em estornos (transaction.refunded)
+	OriginalTransactionID string `json:"original_transaction_id,omitempty"`
+
+	// Lançamentos journal: sem from/to, as pernas vêm em legs.
+	// Depósitos e saques: from/to é a carteira de liquidação de um lado e a do cliente do outro.
+	Kind        string           `json:"kind,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Legs        []TransactionLeg `json:"legs,omitempty"`
+}
+
+type TransactionLeg struct {
+	WalletID int64  `json:"wallet_id"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
 }
 
 func main() {
@@ -124,15 +147,18 @@ func main() {
 
 	//  Bind (Amarração) - Ligar a Fila ao Exchange
 	// "Tudo que começar com 'transaction.' vai para a 'audit_queue'"
-	err = ch.QueueBind(
-		q.Name,          // queue name
-		"transaction.#", // routing key (# é curinga/wildcard)
-		"ledger_events", // exchange
-		false,
-		nil,
-	)
-	if err != nil {
-		log.Fatalf("Erro ao fazer bind da fila: %v", err)
+	// Depósitos e saques têm routing keys próprias (deposit.completed, withdrawal.failed...)
+	for _, routingKey := range []string{"transaction.#", "deposit.#", "withdrawal.#"} {
+		err = ch.QueueBind(
+			q.Name,          // queue name
+			routingKey,      // routing key (# é curinga/wildcard)
+			"ledger_events", // exchange
+			false,
+			nil,
+		)
+		if err != nil {
+			log.Fatalf("Erro ao fazer bind da fila: %v", err)
+		}
 	}
 
 	// Iniciar Consumo
@@ -187,7 +213,26 @@ func main() {
 					FromWallet:    event.FromWallet,
 					ToWallet:      event.ToWallet,
 					Amount:        event.Amount,
+					FeeAmount:     event.FeeAmount,
+					Currency:      event.Currency,
 					Status:        event.Status,
+
+					DestinationAmount:   event.DestinationAmount,
+					DestinationCurrency: event.DestinationCurrency,
+					FXRate:              event.FXRate,
+					FXQuoteID:           event.FXQuoteID,
+
+					OriginalTransactionID: event.OriginalTransactionID,
+
+					Kind:        event.Kind,
+					Description: event.Description,
+				}
+				for _, leg := range event.Legs {
+					auditLog.Legs = append(auditLog.Legs, mongodb.AuditLeg{
+						WalletID: leg.WalletID,
+						Amount:   leg.Amount,
+						Currency: leg.Currency,
+					})
 				}
 
 				saveCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// BalanceSnapshot é a fotografia do saldo de fim de dia de uma carteira.
+// Balance é a soma das partidas com created_at anterior a ClosesAt (a meia-noite que encerra Date).
+type BalanceSnapshot struct {
+	WalletID  int64
+	Date      time.Time // Dia do calendário de Brasília
+	ClosesAt  time.Time
+	Balance   int64
+	CreatedAt time.Time
+}
+
+// DailyNetAmount é o movimento líquido de uma carteira num dia do calendário de Brasília
+type DailyNetAmount struct {
+	Date   time.Time
+	Amount int64
+}
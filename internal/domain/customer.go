@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Tipos de documento do cliente
+const (
+	DocumentTypeCPF  = "cpf"  // Pessoa física (11 dígitos)
+	DocumentTypeCNPJ = "cnpj" // Pessoa jurídica (14 caracteres)
+)
+
+// Situação da verificação de identidade (KYC) do cliente
+const (
+	KYCStatusPending  = "pending"
+	KYCStatusVerified = "verified"
+	KYCStatusRejected = "rejected"
+)
+
+const maxCustomerNameLength = 200
+
+// Customer é o titular das carteiras. Um cliente pode ter várias carteiras.
+type Customer struct {
+	ID           int64
+	Name         string
+	DocumentType string
+	Document     string // Só dígitos (e letras no CNPJ alfanumérico), sem pontuação
+	Email        string
+	Phone        string // Opcional: dígitos com '+' opcional no início
+	KYCStatus    string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// NewCustomer normaliza e valida os dados de um novo cliente (KYC começa pendente)
+func NewCustomer(name, document, email, phone string) (*Customer, error) {
+	documentType, number, err := ParseDocument(document)
+	if err != nil {
+		return nil, err
+	}
+	customer := &Customer{
+		Name:         strings.TrimSpace(name),
+		DocumentType: documentType,
+		Document:     number,
+		Email:        strings.TrimSpace(email),
+		KYCStatus:    KYCStatusPending,
+	}
+	if customer.Phone, err = NormalizePhone(phone); err != nil {
+		return nil, err
+	}
+	if err := customer.Validate(); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
+
+// Validate confere os campos alteráveis do cliente (o documento é validado na criação e não muda)
+func (c *Customer) Validate() error {
+	if c.Name == "" || utf8.RuneCountInString(c.Name) > maxCustomerNameLength {
+		return ErrInvalidCustomerName
+	}
+	// Só o endereço: "Fulano <fulano@x.com>" não é aceito
+	address, err := mail.ParseAddress(c.Email)
+	if err != nil || address.Address != c.Email {
+		return ErrInvalidEmail
+	}
+	switch c.KYCStatus {
+	case KYCStatusPending, KYCStatusVerified, KYCStatusRejected:
+		return nil
+	}
+	return ErrInvalidKYCStatus
+}
+
+// NormalizePhone remove a formatação do telefone: "+55 (11) 99999-8888" -> "+5511999998888".
+// Vazio é aceito (telefone não informado).
+func NormalizePhone(raw string) (string, error) {
+	var b strings.Builder
+	for i, r := range strings.TrimSpace(raw) {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return "", ErrInvalidPhone
+		}
+	}
+	phone := b.String()
+	if phone == "" {
+		return "", nil
+	}
+	if digits := len(strings.TrimPrefix(phone, "+")); digits < 10 || digits > 15 {
+		return "", ErrInvalidPhone
+	}
+	return phone, nil
+}
+
+// ParseDocument remove a pontuação e confere os dígitos verificadores do CPF ou do CNPJ.
+// O tipo é deduzido pelo tamanho: 11 caracteres = CPF, 14 = CNPJ.
+func ParseDocument(raw string) (string, string, error) {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(strings.TrimSpace(raw)) {
+		switch {
+		case r >= '0' && r <= '9', r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		case r == '.' || r == '-' || r == '/' || r == ' ':
+		default:
+			return "", "", ErrInvalidDocument
+		}
+	}
+	document := b.String()
+
+	switch len(document) {
+	case 11:
+		if validCPF(document) {
+			return DocumentTypeCPF, document, nil
+		}
+	case 14:
+		if validCNPJ(document) {
+			return DocumentTypeCNPJ, document, nil
+		}
+	}
+	return "", "", ErrInvalidDocument
+}
+
+// validCPF: módulo 11 com pesos decrescentes a partir de 10 (primeiro dígito) e 11 (segundo)
+func validCPF(cpf string) bool {
+	if !allDigits(cpf) || repeated(cpf) {
+		return false
+	}
+	for _, size := range []int{9, 10} {
+		sum := 0
+		for i := 0; i < size; i++ {
+			sum += int(cpf[i]-'0') * (size + 1 - i)
+		}
+		digit := sum * 10 % 11
+		if digit == 10 {
+			digit = 0
+		}
+		if digit != int(cpf[size]-'0') {
+			return false
+		}
+	}
+	return true
+}
+
+// validCNPJ: módulo 11 com pesos de 2 a 9, da direita para a esquerda.
+// Aceita o CNPJ alfanumérico: cada caractere da raiz/ordem vale o código ASCII - 48
+// (os dois dígitos verificadores continuam numéricos).
+func validCNPJ(cnpj string) bool {
+	if !allDigits(cnpj[12:]) || repeated(cnpj) {
+		return false
+	}
+	for _, size := range []int{12, 13} {
+		sum := 0
+		weight := 2
+		for i := size - 1; i >= 0; i-- {
+			sum += int(cnpj[i]-'0') * weight
+			if weight++; weight > 9 {
+				weight = 2
+			}
+		}
+		digit := 0
+		if rest := sum % 11; rest >= 2 {
+			digit = 11 - rest
+		}
+		if digit != int(cnpj[size]-'0') {
+			return false
+		}
+	}
+	return true
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// repeated detecta documentos como 111.111.111-11, que passam no cálculo mas não existem
+func repeated(s string) bool {
+	return strings.Count(s, s[:1]) == len(s)
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDocument(t *testing.T) {
+	tests := []struct {
+		name         string
+		raw          string
+		wantType     string
+		wantDocument string
+		wantErr      bool
+	}{
+		{name: "CPF com pontuação", raw: "529.982.247-25", wantType: DocumentTypeCPF, wantDocument: "52998224725"},
+		{name: "CPF só com dígitos", raw: "11144477735", wantType: DocumentTypeCPF, wantDocument: "11144477735"},
+		{name: "CPF com espaços em volta", raw: "  111.444.777-35 ", wantType: DocumentTypeCPF, wantDocument: "11144477735"},
+		{name: "CPF com primeiro dígito 10 vira 0", raw: "123.456.789-09", wantType: DocumentTypeCPF, wantDocument: "12345678909"},
+		{name: "CPF com primeiro dígito verificador errado", raw: "529.982.247-35", wantErr: true},
+		{name: "CPF com segundo dígito verificador errado", raw: "529.982.247-26", wantErr: true},
+		{name: "CPF com dígitos repetidos", raw: "111.111.111-11", wantErr: true},
+		{name: "CPF zerado", raw: "000.000.000-00", wantErr: true},
+		{name: "CPF com letra", raw: "529.982.24A-25", wantErr: true},
+
+		{name: "CNPJ numérico com pontuação", raw: "11.222.333/0001-81", wantType: DocumentTypeCNPJ, wantDocument: "11222333000181"},
+		{name: "CNPJ numérico só com dígitos", raw: "45997418000153", wantType: DocumentTypeCNPJ, wantDocument: "45997418000153"},
+		{name: "CNPJ com dígito verificador errado", raw: "11.222.333/0001-82", wantErr: true},
+		{name: "CNPJ com dígitos repetidos", raw: "11.111.111/1111-11", wantErr: true},
+		{name: "CNPJ zerado", raw: "00.000.000/0000-00", wantErr: true},
+		{name: "CNPJ alfanumérico", raw: "12.ABC.345/01DE-35", wantType: DocumentTypeCNPJ, wantDocument: "12ABC34501DE35"},
+		{name: "CNPJ alfanumérico em minúsculas", raw: "12.abc.345/01de-35", wantType: DocumentTypeCNPJ, wantDocument: "12ABC34501DE35"},
+		{name: "CNPJ alfanumérico com dígito verificador errado", raw: "12.ABC.345/01DE-36", wantErr: true},
+		{name: "CNPJ alfanumérico com letra no dígito verificador", raw: "12.ABC.345/01DE-3A", wantErr: true},
+
+		{name: "vazio", raw: "", wantErr: true},
+		{name: "tamanho de nenhum dos dois", raw: "1234567890", wantErr: true},
+		{name: "caractere não permitido", raw: "529_982_247_25", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docType, document, err := ParseDocument(tt.raw)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidDocument) {
+					t.Fatalf("ParseDocument(%q) error = %v, want ErrInvalidDocument", tt.raw, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDocument(%q) error = %v", tt.raw, err)
+			}
+			if docType != tt.wantType || document != tt.wantDocument {
+				t.Errorf("ParseDocument(%q) = (%q, %q), want (%q, %q)", tt.raw, docType, document, tt.wantType, tt.wantDocument)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// Entry é um lançamento (partida) no livro-razão.
+// Toda Transaction gera pelo menos duas Entries cuja soma é zero (partidas dobradas).
+type Entry struct {
+	ID            int64
+	TransactionID string // Vazio apenas nos saldos de abertura antigos (hoje o dinheiro entra por depósito)
+	WalletID      int64
+	Amount        int64 // Na menor unidade da moeda. Negativo = débito, Positivo = crédito
+	Currency      Currency
+	CreatedAt     time.Time
+}
+
+// IsDebit indica se o lançamento retira dinheiro da carteira
+func (e *Entry) IsDebit() bool {
+	return e.Amount < 0
+}
+
+// ValidateBalanced garante a regra de ouro das partidas dobradas:
+// cada lançamento tem valor e, para cada moeda, a soma é exatamente zero.
+func ValidateBalanced(entries []Entry) error {
+	if len(entries) < 2 {
+		return ErrUnbalancedEntries
+	}
+
+	sums := make(map[Currency]int64)
+	for _, e := range entries {
+		if e.Amount == 0 {
+			return ErrInvalidAmount
+		}
+		if _, ok := minorUnits[e.Currency]; !ok {
+			return ErrUnsupportedCurrency
+		}
+		sums[e.Currency] += e.Amount
+	}
+
+	for _, sum := range sums {
+		if sum != 0 {
+			return ErrUnbalancedEntries
+		}
+	}
+	return nil
+}
@@ -3,9 +3,75 @@ package domain
 import "errors"
 
 var (
-	ErrInsufficientFunds = errors.New("insufficient funds")
-	ErrInvalidAmount     = errors.New("transaction amount must be greater than zero")
-	ErrWalletNotFound    = errors.New("wallet not found")
-	ErrTransactionFailed = errors.New("transaction failed")
-	ErrIdempotencyKey    = errors.New("idempotency key conflict")
+	ErrInsufficientFunds       = errors.New("insufficient funds")
+	ErrInvalidAmount           = errors.New("transaction amount must be greater than zero")
+	ErrWalletNotFound          = errors.New("wallet not found")
+	ErrTransactionFailed       = errors.New("transaction failed")
+	ErrIdempotencyKey          = errors.New("idempotency key conflict")
+	ErrUnbalancedEntries       = errors.New("ledger entries must sum to zero")
+	ErrInvalidCursor           = errors.New("invalid pagination cursor")
+	ErrTransactionNotFound     = errors.New("transaction not found")
+	ErrUnsupportedCurrency     = errors.New("unsupported currency")
+	ErrCurrencyMismatch        = errors.New("source and destination currencies differ")
+	ErrSystemWallet            = errors.New("system wallets can only be moved by the ledger itself")
+	ErrInvalidRate             = errors.New("invalid exchange rate")
+	ErrRateUnavailable         = errors.New("exchange rate unavailable for currency pair")
+	ErrQuoteNotFound           = errors.New("fx quote not found")
+	ErrQuoteExpired            = errors.New("fx quote expired")
+	ErrQuoteAlreadyUsed        = errors.New("fx quote already used")
+	ErrQuoteMismatch           = errors.New("transfer does not match fx quote")
+	ErrHoldNotFound            = errors.New("hold not found")
+	ErrHoldNotActive           = errors.New("hold is no longer active")
+	ErrHoldExpired             = errors.New("hold expired")
+	ErrHoldAmountExceeded      = errors.New("capture amount exceeds held amount")
+	ErrInvalidExpiration       = errors.New("invalid hold expiration")
+	ErrSameWallet              = errors.New("source and destination wallets must differ")
+	ErrRefundExceedsAmount     = errors.New("refund exceeds refundable amount")
+	ErrNotRefundable           = errors.New("transaction cannot be refunded")
+	ErrScheduleNotFound        = errors.New("scheduled transfer not found")
+	ErrScheduleNotPending      = errors.New("scheduled transfer is no longer pending")
+	ErrScheduleClaimLost       = errors.New("scheduled transfer is no longer claimed by this run")
+	ErrInvalidSchedule         = errors.New("execution time must be in the future")
+	ErrInvalidRecurrence       = errors.New("invalid recurrence rule")
+	ErrOrderNotFound           = errors.New("standing order not found")
+	ErrOrderNotActive          = errors.New("standing order is not active")
+	ErrInvalidOrderPolicy      = errors.New("invalid insufficient funds policy")
+	ErrInvalidOrderStatus      = errors.New("standing order status can only be set to active or paused")
+	ErrInvalidBatch            = errors.New("invalid transfer batch")
+	ErrInvalidBatchMode        = errors.New("batch mode must be all_or_nothing or best_effort")
+	ErrInvalidJournal          = errors.New("invalid number of journal entry legs")
+	ErrInvalidOverdraftLimit   = errors.New("overdraft limit must be zero or positive")
+	ErrLimitBelowBalance       = errors.New("overdraft limit does not cover the current negative balance")
+	ErrInvalidLimitReason      = errors.New("a reason is required to change the overdraft limit")
+	ErrWalletFrozen            = errors.New("wallet is frozen")
+	ErrWalletClosed            = errors.New("wallet is closed")
+	ErrWalletNotEmpty          = errors.New("wallet must have zero balance and no active holds to be closed")
+	ErrInvalidWalletTransition = errors.New("invalid wallet status transition")
+	ErrLimitExceeded           = errors.New("velocity limit exceeded")
+	ErrInvalidLimits           = errors.New("limits must be zero or positive")
+	ErrLimitTierNotFound       = errors.New("limit tier not found")
+	ErrInvalidLimitTier        = errors.New("limit tier code must be lowercase letters, digits or underscore")
+	ErrFeePolicyNotFound       = errors.New("fee policy not found")
+	ErrInvalidFeePolicy        = errors.New("invalid fee policy")
+	ErrInvalidRevenueWallet    = errors.New("revenue wallet must be a system wallet in the policy currency")
+	ErrInterestNotEnabled      = errors.New("interest is not enabled for this wallet")
+	ErrInvalidInterestRate     = errors.New("annual interest rate must be between 0 and 1 with at most 8 decimal places")
+	ErrInvalidDayCount         = errors.New("day count convention must be ACT/365, ACT/360 or ACT/ACT")
+	ErrInvalidInterestDate     = errors.New("interest can only be accrued for past dates")
+	ErrInterestAlreadyPaid     = errors.New("interest already paid for this period")
+	ErrCustomerNotFound        = errors.New("customer not found")
+	ErrInvalidDocument         = errors.New("document must be a valid CPF or CNPJ")
+	ErrDocumentAlreadyExists   = errors.New("a customer with this document already exists")
+	ErrInvalidCustomerName     = errors.New("customer name is required and must have at most 200 characters")
+	ErrInvalidEmail            = errors.New("invalid email address")
+	ErrInvalidPhone            = errors.New("phone must have 10 to 15 digits")
+	ErrInvalidKYCStatus        = errors.New("kyc status must be pending, verified or rejected")
+	ErrCustomerHasWallets      = errors.New("customer still owns wallets")
+	ErrCustomerRequired        = errors.New("wallets must belong to a customer")
+	ErrInvalidSnapshotDate     = errors.New("balance snapshots can only be taken for past dates")
+	ErrInvalidBalanceDate      = errors.New("balance date cannot be in the future")
+	ErrInvalidDateRange        = errors.New("invalid date range")
+	ErrIdempotencyKeyRequired  = errors.New("idempotency key is required")
+	ErrIdempotencyKeyNotFound  = errors.New("idempotency key not found")
+	ErrIdempotencyKeyMismatch  = errors.New("idempotency key already used by a different transaction")
 )
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// Tipos de política de tarifa
+const (
+	FeeKindFlat       = "flat"       // Valor fixo por transferência
+	FeeKindPercentage = "percentage" // Percentual em basis points, com mínimo/máximo opcionais
+	FeeKindTiered     = "tiered"     // Valor fixo conforme a faixa de valor da transferência
+)
+
+// MaxPercentageBps é 100% em basis points
+const MaxPercentageBps = 10000
+
+// FeeBracket é uma faixa da política tiered: transferências até UpTo (inclusive) pagam Fee.
+// UpTo nil fecha a tabela (sem teto) e só pode aparecer na última faixa.
+type FeeBracket struct {
+	UpTo *int64 `json:"up_to"`
+	Fee  int64  `json:"fee"`
+}
+
+// FeePolicy define a tarifa de uma faixa (a mesma dos limites) em uma moeda.
+// Os valores estão na menor unidade da moeda.
+type FeePolicy struct {
+	ID            int64
+	Tier          string
+	Currency      Currency
+	Kind          string
+	FlatAmount    int64
+	PercentageBps int64
+	MinAmount     *int64
+	MaxAmount     *int64
+	Brackets      []FeeBracket
+	// nil = carteira de sistema fee_revenue da moeda
+	RevenueWalletID *int64
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Validate confere os parâmetros do tipo escolhido
+func (p *FeePolicy) Validate() error {
+	if _, err := ParseCurrency(string(p.Currency)); err != nil {
+		return err
+	}
+	switch p.Kind {
+	case FeeKindFlat:
+		if p.FlatAmount < 0 {
+			return ErrInvalidFeePolicy
+		}
+	case FeeKindPercentage:
+		if p.PercentageBps < 0 || p.PercentageBps > MaxPercentageBps {
+			return ErrInvalidFeePolicy
+		}
+		if p.MinAmount != nil && *p.MinAmount < 0 || p.MaxAmount != nil && *p.MaxAmount < 0 {
+			return ErrInvalidFeePolicy
+		}
+		if p.MinAmount != nil && p.MaxAmount != nil && *p.MinAmount > *p.MaxAmount {
+			return ErrInvalidFeePolicy
+		}
+	case FeeKindTiered:
+		if len(p.Brackets) == 0 {
+			return ErrInvalidFeePolicy
+		}
+		var previous int64
+		for i, bracket := range p.Brackets {
+			if bracket.Fee < 0 {
+				return ErrInvalidFeePolicy
+			}
+			if bracket.UpTo == nil {
+				// A faixa sem teto precisa ser a última
+				if i != len(p.Brackets)-1 {
+					return ErrInvalidFeePolicy
+				}
+				continue
+			}
+			if *bracket.UpTo <= previous {
+				return ErrInvalidFeePolicy
+			}
+			previous = *bracket.UpTo
+		}
+	default:
+		return ErrInvalidFeePolicy
+	}
+	return nil
+}
+
+// Calculate retorna a tarifa de uma transferência de 'amount'
+func (p *FeePolicy) Calculate(amount int64) int64 {
+	switch p.Kind {
+	case FeeKindFlat:
+		return p.FlatAmount
+	case FeeKindPercentage:
+		// amount * bps / 10000, arredondando meio centavo para cima (em big.Int para não estourar)
+		fee := new(big.Int).Mul(big.NewInt(amount), big.NewInt(p.PercentageBps))
+		fee.Add(fee, big.NewInt(MaxPercentageBps/2))
+		fee.Quo(fee, big.NewInt(MaxPercentageBps))
+		result := fee.Int64()
+		if p.MinAmount != nil && result < *p.MinAmount {
+			result = *p.MinAmount
+		}
+		if p.MaxAmount != nil && result > *p.MaxAmount {
+			result = *p.MaxAmount
+		}
+		return result
+	case FeeKindTiered:
+		for _, bracket := range p.Brackets {
+			if bracket.UpTo == nil || amount <= *bracket.UpTo {
+				return bracket.Fee
+			}
+		}
+		// Acima da última faixa com teto: vale a tarifa da última
+		if len(p.Brackets) > 0 {
+			return p.Brackets[len(p.Brackets)-1].Fee
+		}
+	}
+	return 0
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFeePolicyValidateBrackets(t *testing.T) {
+	upTo := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name     string
+		brackets []FeeBracket
+		wantErr  error
+	}{
+		{name: "faixas crescentes com a última sem teto", brackets: []FeeBracket{{UpTo: upTo(1_000), Fee: 0}, {UpTo: upTo(10_000), Fee: 100}, {Fee: 300}}},
+		{name: "faixas só com teto", brackets: []FeeBracket{{UpTo: upTo(1_000), Fee: 50}, {UpTo: upTo(2_000), Fee: 80}}},
+		{name: "uma faixa sem teto", brackets: []FeeBracket{{Fee: 200}}},
+		{name: "sem faixas", wantErr: ErrInvalidFeePolicy},
+		{name: "teto repetido", brackets: []FeeBracket{{UpTo: upTo(1_000), Fee: 50}, {UpTo: upTo(1_000), Fee: 80}}, wantErr: ErrInvalidFeePolicy},
+		{name: "teto decrescente", brackets: []FeeBracket{{UpTo: upTo(2_000), Fee: 50}, {UpTo: upTo(1_000), Fee: 80}}, wantErr: ErrInvalidFeePolicy},
+		{name: "teto zero", brackets: []FeeBracket{{UpTo: upTo(0), Fee: 50}}, wantErr: ErrInvalidFeePolicy},
+		{name: "faixa sem teto antes da última", brackets: []FeeBracket{{Fee: 50}, {UpTo: upTo(1_000), Fee: 80}}, wantErr: ErrInvalidFeePolicy},
+		{name: "tarifa negativa", brackets: []FeeBracket{{UpTo: upTo(1_000), Fee: -1}}, wantErr: ErrInvalidFeePolicy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &FeePolicy{Currency: CurrencyBRL, Kind: FeeKindTiered, Brackets: tt.brackets}
+			if err := policy.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
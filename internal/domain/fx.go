@@ -0,0 +1,77 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// RatePrecision é o número de casas decimais com que as taxas são armazenadas (NUMERIC(20,10))
+const RatePrecision = 10
+
+// ExchangeRate informa quantas unidades de To valem 1 unidade de From.
+// Usamos big.Rat para nunca passar dinheiro por float.
+type ExchangeRate struct {
+	From Currency
+	To   Currency
+	Rate *big.Rat
+}
+
+// ParseRate converte uma taxa decimal ("5.4321") para big.Rat
+func ParseRate(s string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(s)
+	if !ok || rate.Sign() <= 0 {
+		return nil, ErrInvalidRate
+	}
+	return rate, nil
+}
+
+// Convert converte um valor na menor unidade de From para a menor unidade de To,
+// respeitando o expoente de cada moeda. Arredonda para baixo: a fração residual fica com a casa.
+func (r ExchangeRate) Convert(amount int64) (int64, error) {
+	if amount <= 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	value := new(big.Rat).SetInt64(amount)
+	value.Mul(value, r.Rate)
+
+	// Ajusta a escala entre as menores unidades (ex: JPY tem 0 casas, BRL tem 2)
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(r.To.Exponent()-r.From.Exponent()))), nil))
+	if r.To.Exponent() >= r.From.Exponent() {
+		value.Mul(value, scale)
+	} else {
+		value.Quo(value, scale)
+	}
+
+	converted := new(big.Int).Quo(value.Num(), value.Denom())
+	if !converted.IsInt64() || converted.Sign() <= 0 {
+		return 0, ErrInvalidAmount
+	}
+	return converted.Int64(), nil
+}
+
+// FXQuote é uma cotação travada por um curto período.
+// A transferência executada contra ela usa exatamente a taxa e os valores cotados.
+type FXQuote struct {
+	ID                  string
+	SourceCurrency      Currency
+	DestinationCurrency Currency
+	Rate                *big.Rat
+	SourceAmount        int64
+	DestinationAmount   int64
+	ExpiresAt           time.Time
+	UsedAt              *time.Time
+	CreatedAt           time.Time
+}
+
+// IsExpired indica se a cotação não pode mais ser executada
+func (q *FXQuote) IsExpired(now time.Time) bool {
+	return !now.Before(q.ExpiresAt)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
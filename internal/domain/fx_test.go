@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestExchangeRateConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    Currency
+		to      Currency
+		rate    string
+		amount  int64
+		want    int64
+		wantErr error
+	}{
+		{name: "mesmo expoente", from: CurrencyBRL, to: CurrencyUSD, rate: "0.2", amount: 10_000, want: 2_000},
+		{name: "taxa com 10 casas", from: CurrencyUSD, to: CurrencyBRL, rate: "5.4321098765", amount: 12_345, want: 67_059},
+		{name: "BRL para JPY divide a escala", from: CurrencyBRL, to: "JPY", rate: "27.5", amount: 1_000, want: 275},
+		{name: "JPY para BRL multiplica a escala", from: "JPY", to: CurrencyBRL, rate: "0.04", amount: 275, want: 1_100},
+		{name: "JPY para BRL trunca a fração", from: "JPY", to: CurrencyBRL, rate: "0.0363636", amount: 275, want: 999},
+		{name: "KWD (3 casas) para BRL", from: "KWD", to: CurrencyBRL, rate: "16.5", amount: 1_005, want: 1_658},
+		{name: "BRL para KWD (3 casas)", from: CurrencyBRL, to: "KWD", rate: "0.0606", amount: 100, want: 60},
+		{name: "JPY para KWD (3 casas de diferença)", from: "JPY", to: "KWD", rate: "0.002", amount: 1_000, want: 2_000},
+		{name: "meio centavo arredonda para baixo", from: CurrencyUSD, to: CurrencyBRL, rate: "5.5", amount: 1, want: 5},
+		{name: "meio iene arredonda para baixo", from: CurrencyBRL, to: "JPY", rate: "50", amount: 3, want: 1},
+		{name: "menos de uma unidade no destino", from: CurrencyBRL, to: "JPY", rate: "50", amount: 1, wantErr: ErrInvalidAmount},
+		{name: "valor zero", from: CurrencyBRL, to: CurrencyUSD, rate: "0.2", amount: 0, wantErr: ErrInvalidAmount},
+		{name: "valor negativo", from: CurrencyBRL, to: CurrencyUSD, rate: "0.2", amount: -100, wantErr: ErrInvalidAmount},
+		{name: "resultado acima de int64", from: CurrencyUSD, to: CurrencyBRL, rate: "2", amount: math.MaxInt64, wantErr: ErrInvalidAmount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate := ExchangeRate{From: tt.from, To: tt.to, Rate: mustParseRat(t, tt.rate)}
+			got, err := rate.Convert(tt.amount)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Convert(%d) error = %v, want %v", tt.amount, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Convert(%d) = %d, want %d", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
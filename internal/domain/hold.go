@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"time"
+)
+
+// Status de uma autorização
+const (
+	HoldStatusActive   = "active"
+	HoldStatusCaptured = "captured"
+	HoldStatusVoided   = "voided"
+	HoldStatusExpired  = "expired"
+)
+
+// Prazos de uma autorização quando o cliente não informa (ou exagera) a validade
+const (
+	DefaultHoldTTL = 7 * 24 * time.Hour
+	MaxHoldTTL     = 30 * 24 * time.Hour
+)
+
+// Hold é uma autorização: reserva saldo da carteira sem movimentar o dinheiro.
+// A captura vira uma transferência de verdade para a carteira do lojista (ToWalletID).
+type Hold struct {
+	ID             string
+	WalletID       int64
+	ToWalletID     int64
+	Amount         int64 // Valor reservado
+	CapturedAmount int64 // Preenchido na captura (pode ser menor que Amount)
+	Currency       Currency
+	Status         string
+	TransactionID  string // Transferência gerada pela captura
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsActive indica se a autorização ainda segura saldo na carteira
+func (h *Hold) IsActive() bool {
+	return h.Status == HoldStatusActive
+}
+
+// IsExpired indica se a autorização passou do prazo (mesmo que a varredura ainda não a tenha fechado)
+func (h *Hold) IsExpired(now time.Time) bool {
+	return !now.Before(h.ExpiresAt)
+}
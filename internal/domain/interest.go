@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+)
+
+// Convenções de contagem de dias: por quantos dias a taxa anual é dividida
+const (
+	DayCountACT365 = "ACT/365" // Ano fixo de 365 dias
+	DayCountACT360 = "ACT/360" // Ano comercial de 360 dias
+	DayCountACTACT = "ACT/ACT" // Dias reais do ano (366 nos bissextos)
+)
+
+// InterestRatePrecision é o número de casas decimais da taxa anual (NUMERIC(10,8): 0.10750000 = 10,75% a.a.)
+const InterestRatePrecision = 8
+
+// Os juros diários são guardados sem arredondamento, em unidades de 1/InterestUnitsPerMinor
+// da menor unidade da moeda. Com a taxa limitada a 8 casas e o ano de 360, 365 ou 366 dias,
+// saldo × taxa ÷ dias do ano sempre é um número inteiro de unidades:
+// InterestUnitsPerMinor = 10^8 × mmc(360, 365, 366).
+const (
+	interestRateScale     = 100_000_000
+	interestDayBasisLCM   = 1_603_080
+	InterestUnitsPerMinor = interestRateScale * interestDayBasisLCM
+)
+
+// SystemWalletInterestTreasury paga os juros das carteiras (uma por moeda)
+const SystemWalletInterestTreasury = "interest_treasury"
+
+// InterestAccount é a configuração de rendimento de uma carteira de poupança
+type InterestAccount struct {
+	WalletID   int64
+	AnnualRate *big.Rat // 0.1075 = 10,75% a.a.
+	DayCount   string
+	// Primeiro dia (horário de Brasília) que rende juros
+	StartDate time.Time
+	// Fração de centavo acumulada e ainda não paga (em unidades de juros)
+	CarryUnits *big.Int
+	UpdatedAt  time.Time
+}
+
+// Validate confere a taxa (entre 0% e 100% a.a., até 8 casas) e a convenção de dias
+func (a *InterestAccount) Validate() error {
+	if a.AnnualRate == nil || a.AnnualRate.Sign() < 0 || a.AnnualRate.Cmp(big.NewRat(1, 1)) > 0 {
+		return ErrInvalidInterestRate
+	}
+	if !new(big.Rat).Mul(a.AnnualRate, big.NewRat(interestRateScale, 1)).IsInt() {
+		return ErrInvalidInterestRate
+	}
+	switch a.DayCount {
+	case DayCountACT365, DayCountACT360, DayCountACTACT:
+		return nil
+	}
+	return ErrInvalidDayCount
+}
+
+// DailyAccrual calcula os juros de um dia sobre o saldo de fim de dia, em unidades de juros.
+// Saldo zero ou negativo (cheque especial) não rende.
+func (a *InterestAccount) DailyAccrual(balance int64, date time.Time) *big.Int {
+	if balance <= 0 {
+		return new(big.Int)
+	}
+	scaledRate := new(big.Rat).Mul(a.AnnualRate, big.NewRat(interestRateScale, 1)).Num()
+
+	units := new(big.Int).Mul(big.NewInt(balance), scaledRate)
+	return units.Mul(units, big.NewInt(interestDayBasisLCM/daysInYear(a.DayCount, date)))
+}
+
+func daysInYear(dayCount string, date time.Time) int64 {
+	switch dayCount {
+	case DayCountACT360:
+		return 360
+	case DayCountACTACT:
+		if year := date.Year(); year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 366
+		}
+	}
+	return 365
+}
+
+// SplitInterestUnits separa um total de unidades de juros no valor pagável (menor unidade da moeda)
+// e na fração que fica para o próximo pagamento
+func SplitInterestUnits(total *big.Int) (int64, *big.Int) {
+	amount, carry := new(big.Int).QuoRem(total, big.NewInt(InterestUnitsPerMinor), new(big.Int))
+	return amount.Int64(), carry
+}
+
+// InterestAccrual é o rendimento de um dia de uma carteira.
+// Guarda a taxa e a convenção usadas, já que a configuração pode mudar depois.
+type InterestAccrual struct {
+	WalletID   int64
+	Date       time.Time
+	Balance    int64
+	AnnualRate *big.Rat
+	DayCount   string
+	Units      *big.Int
+}
+
+// InterestPayout é o pagamento mensal dos juros acumulados de uma carteira
+type InterestPayout struct {
+	ID            int64
+	WalletID      int64
+	PeriodStart   time.Time // Primeiro dia do mês pago
+	Amount        int64
+	TransactionID string // Vazio quando o valor do mês não chegou a um centavo
+	CreatedAt     time.Time
+}
+
+// BusinessDate normaliza um instante para a data (meia-noite) no horário de Brasília
+func BusinessDate(t time.Time) time.Time {
+	local := t.In(BusinessLocation)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, BusinessLocation)
+}
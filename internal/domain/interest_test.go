@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestInterestAccountValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rate     string
+		dayCount string
+		wantErr  error
+	}{
+		{name: "taxa com 8 casas", rate: "0.10750000", dayCount: DayCountACT365},
+		{name: "taxa zero", rate: "0", dayCount: DayCountACT360},
+		{name: "100% a.a.", rate: "1", dayCount: DayCountACTACT},
+		{name: "acima de 100%", rate: "1.00000001", dayCount: DayCountACT365, wantErr: ErrInvalidInterestRate},
+		{name: "negativa", rate: "-0.01", dayCount: DayCountACT365, wantErr: ErrInvalidInterestRate},
+		{name: "mais de 8 casas", rate: "0.107500001", dayCount: DayCountACT365, wantErr: ErrInvalidInterestRate},
+		{name: "convenção desconhecida", rate: "0.1", dayCount: "30/360", wantErr: ErrInvalidDayCount},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := &InterestAccount{AnnualRate: mustParseRat(t, tt.rate), DayCount: tt.dayCount}
+			if err := account.Validate(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("sem taxa", func(t *testing.T) {
+		account := &InterestAccount{DayCount: DayCountACT365}
+		if err := account.Validate(); !errors.Is(err, ErrInvalidInterestRate) {
+			t.Errorf("Validate() error = %v, want ErrInvalidInterestRate", err)
+		}
+	})
+}
+
+func TestDailyAccrual(t *testing.T) {
+	tests := []struct {
+		name     string
+		dayCount string
+		date     string
+		balance  int64
+		wantDays int64 // Dias do ano usados na divisão; 0 = não rende
+	}{
+		{name: "ACT/365", dayCount: DayCountACT365, date: "2026-03-10", balance: 100_000, wantDays: 365},
+		{name: "ACT/365 em ano bissexto", dayCount: DayCountACT365, date: "2028-03-10", balance: 100_000, wantDays: 365},
+		{name: "ACT/360", dayCount: DayCountACT360, date: "2026-03-10", balance: 100_000, wantDays: 360},
+		{name: "ACT/ACT em ano comum", dayCount: DayCountACTACT, date: "2026-03-10", balance: 100_000, wantDays: 365},
+		{name: "ACT/ACT em ano bissexto", dayCount: DayCountACTACT, date: "2028-03-10", balance: 100_000, wantDays: 366},
+		{name: "ACT/ACT em 2000 (divisível por 400)", dayCount: DayCountACTACT, date: "2000-03-10", balance: 100_000, wantDays: 366},
+		{name: "ACT/ACT em 2100 (secular, não bissexto)", dayCount: DayCountACTACT, date: "2100-03-10", balance: 100_000, wantDays: 365},
+		{name: "saldo de um centavo", dayCount: DayCountACT360, date: "2026-03-10", balance: 1, wantDays: 360},
+		{name: "saldo zero não rende", dayCount: DayCountACT365, date: "2026-03-10", balance: 0},
+		{name: "cheque especial não rende", dayCount: DayCountACT365, date: "2026-03-10", balance: -50_000},
+	}
+
+	rate := mustParseRat(t, "0.10750000")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := time.ParseInLocation("2006-01-02", tt.date, BusinessLocation)
+			if err != nil {
+				t.Fatal(err)
+			}
+			account := &InterestAccount{AnnualRate: rate, DayCount: tt.dayCount}
+			got := account.DailyAccrual(tt.balance, date)
+
+			want := new(big.Int)
+			if tt.wantDays > 0 {
+				// saldo × taxa ÷ dias do ano, em unidades de juros: sempre exato, sem arredondamento
+				exact := new(big.Rat).Mul(big.NewRat(tt.balance, 1), rate)
+				exact.Mul(exact, big.NewRat(InterestUnitsPerMinor, tt.wantDays))
+				if !exact.IsInt() {
+					t.Fatalf("juros de um dia não são inteiros em unidades: %s", exact)
+				}
+				want = exact.Num()
+			}
+			if got.Cmp(want) != 0 {
+				t.Errorf("DailyAccrual(%d) = %s, want %s", tt.balance, got, want)
+			}
+		})
+	}
+}
+
+func TestSplitInterestUnits(t *testing.T) {
+	tests := []struct {
+		name       string
+		total      *big.Int
+		wantAmount int64
+		wantCarry  *big.Int
+	}{
+		{name: "zero", total: big.NewInt(0), wantAmount: 0, wantCarry: big.NewInt(0)},
+		{name: "menos de um centavo fica inteiro no carry", total: big.NewInt(InterestUnitsPerMinor - 1), wantAmount: 0, wantCarry: big.NewInt(InterestUnitsPerMinor - 1)},
+		{name: "um centavo exato", total: big.NewInt(InterestUnitsPerMinor), wantAmount: 1, wantCarry: big.NewInt(0)},
+		{name: "centavos e fração", total: big.NewInt(5*InterestUnitsPerMinor + 7), wantAmount: 5, wantCarry: big.NewInt(7)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			amount, carry := SplitInterestUnits(tt.total)
+			if amount != tt.wantAmount || carry.Cmp(tt.wantCarry) != 0 {
+				t.Errorf("SplitInterestUnits(%s) = (%d, %s), want (%d, %s)", tt.total, amount, carry, tt.wantAmount, tt.wantCarry)
+			}
+		})
+	}
+}
+
+// O carry de um mês entra no total do seguinte: no fim do ano nada se perde no arredondamento
+func TestInterestCarryAcrossPayouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		dayCount    string
+		year        int
+		balance     int64
+		rate        string
+		wantMonths  []int64 // Valor pago em cada mês
+		wantPaidSum int64   // Total pago no ano = saldo × taxa (exato para um ano inteiro)
+	}{
+		{
+			name:        "ACT/365 em ano comum",
+			dayCount:    DayCountACT365,
+			year:        2026,
+			balance:     100_000,
+			rate:        "0.10750000",
+			wantMonths:  []int64{913, 824, 913, 884, 913, 883, 913, 913, 884, 913, 883, 914},
+			wantPaidSum: 10_750,
+		},
+		{
+			name:        "ACT/ACT em ano bissexto",
+			dayCount:    DayCountACTACT,
+			year:        2028,
+			balance:     1_000,
+			rate:        "0.12000000",
+			wantMonths:  []int64{10, 9, 10, 10, 10, 10, 10, 11, 9, 11, 9, 11},
+			wantPaidSum: 120,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := &InterestAccount{AnnualRate: mustParseRat(t, tt.rate), DayCount: tt.dayCount}
+			carry := new(big.Int)
+			var paidSum int64
+			for month := time.January; month <= time.December; month++ {
+				total := new(big.Int).Set(carry)
+				day := time.Date(tt.year, month, 1, 0, 0, 0, 0, BusinessLocation)
+				for ; day.Month() == month; day = day.AddDate(0, 0, 1) {
+					total.Add(total, account.DailyAccrual(tt.balance, day))
+				}
+
+				var amount int64
+				amount, carry = SplitInterestUnits(total)
+				if want := tt.wantMonths[month-1]; amount != want {
+					t.Errorf("%s: paid %d, want %d", month, amount, want)
+				}
+				if carry.Sign() < 0 || carry.Cmp(big.NewInt(InterestUnitsPerMinor)) >= 0 {
+					t.Errorf("%s: carry %s outside [0, %d)", month, carry, int64(InterestUnitsPerMinor))
+				}
+				paidSum += amount
+			}
+
+			if paidSum != tt.wantPaidSum || carry.Sign() != 0 {
+				t.Errorf("paid %d in the year with carry %s, want %d with no carry", paidSum, carry, tt.wantPaidSum)
+			}
+		})
+	}
+}
+
+func mustParseRat(t *testing.T, value string) *big.Rat {
+	t.Helper()
+	rate, ok := new(big.Rat).SetString(value)
+	if !ok {
+		t.Fatalf("invalid rate %q", value)
+	}
+	return rate
+}
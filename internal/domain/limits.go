@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+	_ "time/tzdata" // Garante o fuso de Brasília mesmo em imagens sem tzdata (scratch/distroless)
+)
+
+// BusinessLocation é o fuso das regras de negócio (janelas diária, mensal e noturna)
+var BusinessLocation = mustLoadLocation("America/Sao_Paulo")
+
+func mustLoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		panic(fmt.Sprintf("fuso horário %s indisponível: %v", name, err))
+	}
+	return location
+}
+
+// Janela noturna das regras do Pix: das 20:00 às 06:00 (horário de Brasília)
+const (
+	NightStartHour = 20
+	NightEndHour   = 6
+)
+
+// DefaultLimitTier é a faixa das carteiras sem configuração própria
+const DefaultLimitTier = "standard"
+
+// Identificadores dos limites (aparecem no erro e na API)
+const (
+	LimitMaxSingleAmount   = "max_single_amount"
+	LimitDailyAmount       = "daily_amount"
+	LimitDailyCount        = "daily_count"
+	LimitMonthlyAmount     = "monthly_amount"
+	LimitMonthlyCount      = "monthly_count"
+	LimitNightSingleAmount = "night_single_amount"
+	LimitNightAmount       = "night_amount"
+)
+
+// VelocityLimits são os limites de saída de uma carteira, na menor unidade da moeda dela.
+// nil significa "sem limite" numa faixa e "herda da faixa" numa configuração de carteira.
+type VelocityLimits struct {
+	MaxSingleAmount   *int64
+	DailyAmount       *int64
+	DailyCount        *int64
+	MonthlyAmount     *int64
+	MonthlyCount      *int64
+	NightSingleAmount *int64
+	NightAmount       *int64
+}
+
+// Merge aplica os valores definidos em override sobre os limites atuais
+func (l VelocityLimits) Merge(override VelocityLimits) VelocityLimits {
+	pick := func(base, o *int64) *int64 {
+		if o != nil {
+			return o
+		}
+		return base
+	}
+	return VelocityLimits{
+		MaxSingleAmount:   pick(l.MaxSingleAmount, override.MaxSingleAmount),
+		DailyAmount:       pick(l.DailyAmount, override.DailyAmount),
+		DailyCount:        pick(l.DailyCount, override.DailyCount),
+		MonthlyAmount:     pick(l.MonthlyAmount, override.MonthlyAmount),
+		MonthlyCount:      pick(l.MonthlyCount, override.MonthlyCount),
+		NightSingleAmount: pick(l.NightSingleAmount, override.NightSingleAmount),
+		NightAmount:       pick(l.NightAmount, override.NightAmount),
+	}
+}
+
+// Validate recusa limites negativos
+func (l VelocityLimits) Validate() error {
+	for _, v := range []*int64{l.MaxSingleAmount, l.DailyAmount, l.DailyCount, l.MonthlyAmount, l.MonthlyCount, l.NightSingleAmount, l.NightAmount} {
+		if v != nil && *v < 0 {
+			return ErrInvalidLimits
+		}
+	}
+	return nil
+}
+
+// LimitTier é uma faixa de limites (ex: standard, business) compartilhada por várias carteiras
+type LimitTier struct {
+	Code      string
+	Limits    VelocityLimits
+	UpdatedAt time.Time
+}
+
+// WalletLimits é a configuração de limites de uma carteira: a faixa e os valores que a sobrescrevem
+type WalletLimits struct {
+	WalletID  int64
+	Tier      string
+	Overrides VelocityLimits
+	UpdatedAt time.Time
+}
+
+// LimitWindows são os inícios das janelas de contagem para um instante
+type LimitWindows struct {
+	Now        time.Time
+	DayStart   time.Time
+	MonthStart time.Time
+	// NightStart só é relevante quando Night é true
+	NightStart time.Time
+	Night      bool
+}
+
+// LimitWindowsAt calcula as janelas no horário de Brasília
+func LimitWindowsAt(now time.Time) LimitWindows {
+	local := now.In(BusinessLocation)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, BusinessLocation)
+
+	windows := LimitWindows{
+		Now:        now,
+		DayStart:   dayStart,
+		MonthStart: time.Date(local.Year(), local.Month(), 1, 0, 0, 0, 0, BusinessLocation),
+		NightStart: now,
+	}
+	switch {
+	case local.Hour() >= NightStartHour:
+		windows.Night = true
+		windows.NightStart = time.Date(local.Year(), local.Month(), local.Day(), NightStartHour, 0, 0, 0, BusinessLocation)
+	case local.Hour() < NightEndHour:
+		// Madrugada: a noite começou às 20:00 do dia anterior
+		windows.Night = true
+		windows.NightStart = time.Date(local.Year(), local.Month(), local.Day()-1, NightStartHour, 0, 0, 0, BusinessLocation)
+	}
+	return windows
+}
+
+// OutgoingUsage é o que a carteira já enviou em cada janela (valores e quantidade de transferências)
+type OutgoingUsage struct {
+	DailyAmount   int64
+	DailyCount    int64
+	MonthlyAmount int64
+	MonthlyCount  int64
+	NightAmount   int64
+	NightCount    int64
+}
+
+// LimitExceededError informa qual limite foi violado e quanto ainda resta dele.
+// Para limites de quantidade, Remaining é o número de transferências restantes.
+type LimitExceededError struct {
+	Limit     string
+	Max       int64
+	Remaining int64
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("%s: limit %s of %d exceeded (remaining %d)", ErrLimitExceeded, e.Limit, e.Max, e.Remaining)
+}
+
+// Unwrap permite errors.Is(err, ErrLimitExceeded)
+func (e *LimitExceededError) Unwrap() error {
+	return ErrLimitExceeded
+}
+
+// Check valida uma nova transferência de 'amount' contra os limites, dado o uso já registrado.
+// Os limites noturnos só se aplicam dentro da janela noturna.
+func (l VelocityLimits) Check(amount int64, usage OutgoingUsage, night bool) error {
+	type amountRule struct {
+		name  string
+		max   *int64
+		used  int64
+		apply bool
+	}
+	amountRules := []amountRule{
+		{LimitMaxSingleAmount, l.MaxSingleAmount, 0, true},
+		{LimitNightSingleAmount, l.NightSingleAmount, 0, night},
+		{LimitNightAmount, l.NightAmount, usage.NightAmount, night},
+		{LimitDailyAmount, l.DailyAmount, usage.DailyAmount, true},
+		{LimitMonthlyAmount, l.MonthlyAmount, usage.MonthlyAmount, true},
+	}
+	for _, rule := range amountRules {
+		if !rule.apply || rule.max == nil {
+			continue
+		}
+		if rule.used+amount > *rule.max {
+			return &LimitExceededError{Limit: rule.name, Max: *rule.max, Remaining: max(*rule.max-rule.used, 0)}
+		}
+	}
+
+	countRules := []amountRule{
+		{LimitDailyCount, l.DailyCount, usage.DailyCount, true},
+		{LimitMonthlyCount, l.MonthlyCount, usage.MonthlyCount, true},
+	}
+	for _, rule := range countRules {
+		if rule.max == nil {
+			continue
+		}
+		if rule.used+1 > *rule.max {
+			return &LimitExceededError{Limit: rule.name, Max: *rule.max, Remaining: max(*rule.max-rule.used, 0)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLimitWindowsAt(t *testing.T) {
+	tests := []struct {
+		name           string
+		now            string // Horário de Brasília (UTC-3)
+		wantDayStart   string
+		wantMonthStart string
+		wantNight      bool
+		wantNightStart string // Só conferido quando wantNight
+	}{
+		{name: "05:59 ainda é madrugada", now: "2026-03-10T05:59:59-03:00", wantDayStart: "2026-03-10T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00", wantNight: true, wantNightStart: "2026-03-09T20:00:00-03:00"},
+		{name: "06:00 já é dia", now: "2026-03-10T06:00:00-03:00", wantDayStart: "2026-03-10T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00"},
+		{name: "19:59 ainda é dia", now: "2026-03-10T19:59:59-03:00", wantDayStart: "2026-03-10T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00"},
+		{name: "20:00 começa a noite", now: "2026-03-10T20:00:00-03:00", wantDayStart: "2026-03-10T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00", wantNight: true, wantNightStart: "2026-03-10T20:00:00-03:00"},
+		{name: "21:59 é noite", now: "2026-03-10T21:59:59-03:00", wantDayStart: "2026-03-10T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00", wantNight: true, wantNightStart: "2026-03-10T20:00:00-03:00"},
+		{name: "22:00 é noite", now: "2026-03-10T22:00:00-03:00", wantDayStart: "2026-03-10T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00", wantNight: true, wantNightStart: "2026-03-10T20:00:00-03:00"},
+		{name: "meia-noite abre um dia novo e mantém a noite", now: "2026-03-11T00:00:00-03:00", wantDayStart: "2026-03-11T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00", wantNight: true, wantNightStart: "2026-03-10T20:00:00-03:00"},
+		{name: "madrugada do dia 1 pega a noite do mês anterior", now: "2026-04-01T02:00:00-03:00", wantDayStart: "2026-04-01T00:00:00-03:00", wantMonthStart: "2026-04-01T00:00:00-03:00", wantNight: true, wantNightStart: "2026-03-31T20:00:00-03:00"},
+		{name: "instante em UTC usa o dia de Brasília", now: "2026-03-11T01:30:00Z", wantDayStart: "2026-03-10T00:00:00-03:00", wantMonthStart: "2026-03-01T00:00:00-03:00", wantNight: true, wantNightStart: "2026-03-10T20:00:00-03:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			windows := LimitWindowsAt(mustParseTime(t, tt.now))
+			if want := mustParseTime(t, tt.wantDayStart); !windows.DayStart.Equal(want) {
+				t.Errorf("DayStart = %s, want %s", windows.DayStart, want)
+			}
+			if want := mustParseTime(t, tt.wantMonthStart); !windows.MonthStart.Equal(want) {
+				t.Errorf("MonthStart = %s, want %s", windows.MonthStart, want)
+			}
+			if windows.Night != tt.wantNight {
+				t.Fatalf("Night = %v, want %v", windows.Night, tt.wantNight)
+			}
+			if want := tt.wantNightStart; want != "" && !windows.NightStart.Equal(mustParseTime(t, want)) {
+				t.Errorf("NightStart = %s, want %s", windows.NightStart, want)
+			}
+		})
+	}
+}
+
+func TestVelocityLimitsCheck(t *testing.T) {
+	limit := func(v int64) *int64 { return &v }
+
+	tier := VelocityLimits{
+		MaxSingleAmount:   limit(100_000),
+		DailyAmount:       limit(200_000),
+		DailyCount:        limit(10),
+		MonthlyAmount:     limit(1_000_000),
+		NightSingleAmount: limit(50_000),
+		NightAmount:       limit(100_000),
+	}
+
+	tests := []struct {
+		name          string
+		override      VelocityLimits // Configuração da carteira, aplicada sobre a faixa
+		amount        int64
+		usage         OutgoingUsage
+		night         bool
+		wantLimit     string // "" = dentro dos limites
+		wantRemaining int64
+	}{
+		{name: "dentro da faixa", amount: 100_000},
+		{name: "acima do valor por transferência da faixa", amount: 100_001, wantLimit: LimitMaxSingleAmount, wantRemaining: 100_000},
+		{name: "carteira aumenta o valor por transferência", override: VelocityLimits{MaxSingleAmount: limit(150_000)}, amount: 150_000},
+		{name: "carteira reduz o valor por transferência", override: VelocityLimits{MaxSingleAmount: limit(10_000)}, amount: 10_001, wantLimit: LimitMaxSingleAmount, wantRemaining: 10_000},
+		{name: "carteira zera o diário", override: VelocityLimits{DailyAmount: limit(0)}, amount: 1, wantLimit: LimitDailyAmount},
+		{name: "carteira herda o diário da faixa", override: VelocityLimits{MaxSingleAmount: limit(150_000)}, amount: 150_000, usage: OutgoingUsage{DailyAmount: 60_000}, wantLimit: LimitDailyAmount, wantRemaining: 140_000},
+		{name: "diário até o limite, inclusive", amount: 50_000, usage: OutgoingUsage{DailyAmount: 150_000}},
+		{name: "quantidade diária esgotada", amount: 1, usage: OutgoingUsage{DailyCount: 10}, wantLimit: LimitDailyCount},
+		{name: "carteira aumenta a quantidade diária", override: VelocityLimits{DailyCount: limit(20)}, amount: 1, usage: OutgoingUsage{DailyCount: 10}},
+		{name: "mensal sem limite na faixa nem na carteira", amount: 1, usage: OutgoingUsage{MonthlyCount: 1_000}},
+		{name: "noturno por transferência só vale à noite", amount: 60_000},
+		{name: "noturno por transferência à noite", amount: 60_000, night: true, wantLimit: LimitNightSingleAmount, wantRemaining: 50_000},
+		{name: "carteira aumenta o noturno por transferência", override: VelocityLimits{NightSingleAmount: limit(80_000)}, amount: 60_000, night: true},
+		{name: "acumulado noturno", amount: 50_000, usage: OutgoingUsage{NightAmount: 60_000, DailyAmount: 60_000}, night: true, wantLimit: LimitNightAmount, wantRemaining: 40_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tier.Merge(tt.override).Check(tt.amount, tt.usage, tt.night)
+			if tt.wantLimit == "" {
+				if err != nil {
+					t.Fatalf("Check(%d) error = %v, want nil", tt.amount, err)
+				}
+				return
+			}
+
+			var limitErr *LimitExceededError
+			if !errors.As(err, &limitErr) {
+				t.Fatalf("Check(%d) error = %v, want *LimitExceededError", tt.amount, err)
+			}
+			if limitErr.Limit != tt.wantLimit || limitErr.Remaining != tt.wantRemaining {
+				t.Errorf("limit %s with remaining %d, want %s with remaining %d", limitErr.Limit, limitErr.Remaining, tt.wantLimit, tt.wantRemaining)
+			}
+			if !errors.Is(err, ErrLimitExceeded) {
+				t.Errorf("errors.Is(err, ErrLimitExceeded) = false")
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Currency é um código de moeda ISO 4217 (ex: "BRL", "USD")
+type Currency string
+
+const (
+	CurrencyBRL Currency = "BRL"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+)
+
+// DefaultCurrency é a moeda das carteiras criadas antes do suporte a multi-moeda
+const DefaultCurrency = CurrencyBRL
+
+// minorUnits guarda o expoente ISO 4217 de cada moeda suportada:
+// quantas casas decimais existem entre a unidade e a menor fração.
+// Ex: BRL 2 (1 real = 100 centavos), JPY 0, KWD 3.
+var minorUnits = map[Currency]int{
+	CurrencyBRL: 2,
+	CurrencyUSD: 2,
+	CurrencyEUR: 2,
+	"GBP":       2,
+	"JPY":       0,
+	"CLP":       0,
+	"KWD":       3,
+}
+
+// ParseCurrency valida e normaliza um código de moeda
+func ParseCurrency(code string) (Currency, error) {
+	c := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := minorUnits[c]; !ok {
+		return "", ErrUnsupportedCurrency
+	}
+	return c, nil
+}
+
+// Exponent retorna o número de casas decimais da moeda
+func (c Currency) Exponent() int {
+	return minorUnits[c]
+}
+
+// Money é um valor monetário na menor unidade da moeda.
+// Nunca usamos float para dinheiro: 1500.00 BRL é Money{Amount: 150000, Currency: "BRL"}.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+func NewMoney(amount int64, currency Currency) (Money, error) {
+	if _, ok := minorUnits[currency]; !ok {
+		return Money{}, ErrUnsupportedCurrency
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// ParseMoney monta um valor recebido pela API. A moeda é opcional: vazia, o valor fica na moeda
+// da carteira (resolvida depois com In); informada, precisa ser uma moeda suportada.
+func ParseMoney(amount int64, code string) (Money, error) {
+	if strings.TrimSpace(code) == "" {
+		return Money{Amount: amount}, nil
+	}
+	currency, err := ParseCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// In resolve o valor na moeda da carteira: sem moeda informada, vale a da carteira;
+// com outra moeda, ErrCurrencyMismatch.
+func (m Money) In(currency Currency) (Money, error) {
+	if m.Currency != "" && m.Currency != currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount, Currency: currency}, nil
+}
+
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) Sub(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, ErrCurrencyMismatch
+	}
+	return Money{Amount: m.Amount - other.Amount, Currency: m.Currency}, nil
+}
+
+// FormatAmount formata o valor em unidades da moeda, sem símbolo (ex: "1500.00", "-0.05", "300")
+func (m Money) FormatAmount() string {
+	exp := m.Currency.Exponent()
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	if exp == 0 {
+		return fmt.Sprintf("%s%d", sign, amount)
+	}
+
+	divisor := int64(1)
+	for i := 0; i < exp; i++ {
+		divisor *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/divisor, exp, amount%divisor)
+}
+
+// String formata como "1500.00 BRL"
+func (m Money) String() string {
+	return m.FormatAmount() + " " + string(m.Currency)
+}
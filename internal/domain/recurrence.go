@@ -0,0 +1,254 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequências suportadas (subconjunto do RRULE da RFC 5545)
+const (
+	FrequencyDaily   = "DAILY"
+	FrequencyWeekly  = "WEEKLY"
+	FrequencyMonthly = "MONTHLY"
+)
+
+// LastDayOfMonth em ByMonthDay representa o último dia do mês (BYMONTHDAY=-1)
+const LastDayOfMonth = -1
+
+// maxRecurrencePeriods evita laço infinito ao procurar a próxima ocorrência
+const maxRecurrencePeriods = 10000
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Recurrence é uma regra de repetição no formato RRULE, limitada a:
+// FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL, BYDAY (só WEEKLY), BYMONTHDAY (só MONTHLY), COUNT e UNTIL.
+// As ocorrências herdam o horário de início da ordem.
+// Ex: "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12" = todo dia 5, doze vezes.
+type Recurrence struct {
+	Frequency  string
+	Interval   int            // A cada N períodos (padrão 1)
+	ByDay      []time.Weekday // WEEKLY: dias da semana (padrão: o dia da semana do início)
+	ByMonthDay int            // MONTHLY: dia do mês, 1..31 ou -1 (padrão: o dia do início)
+	Count      int            // Número total de ocorrências (0 = sem limite)
+	Until      *time.Time     // Última data possível (inclusive)
+}
+
+// ParseRRule interpreta uma regra no formato "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12".
+// O prefixo "RRULE:" é opcional.
+func ParseRRule(rule string) (*Recurrence, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return nil, ErrInvalidRecurrence
+	}
+
+	r := &Recurrence{}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRecurrence, part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			r.Frequency = strings.ToUpper(value)
+		case "INTERVAL":
+			r.Interval, err = strconv.Atoi(value)
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				day, ok := weekdayCodes[strings.ToUpper(code)]
+				if !ok {
+					return nil, fmt.Errorf("%w: weekday %q", ErrInvalidRecurrence, code)
+				}
+				r.ByDay = append(r.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = strconv.Atoi(value)
+		case "COUNT":
+			r.Count, err = strconv.Atoi(value)
+		case "UNTIL":
+			var until time.Time
+			until, err = parseRRuleDate(value)
+			r.Until = &until
+		default:
+			return nil, fmt.Errorf("%w: unsupported parameter %s", ErrInvalidRecurrence, key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRecurrence, part)
+		}
+	}
+
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// ParseWeekday converte "MO".."SU" em time.Weekday
+func ParseWeekday(code string) (time.Weekday, error) {
+	day, ok := weekdayCodes[strings.ToUpper(code)]
+	if !ok {
+		return 0, fmt.Errorf("%w: weekday %q", ErrInvalidRecurrence, code)
+	}
+	return day, nil
+}
+
+// UNTIL aceita data (20251231, no fuso de negócio) ou data-hora UTC (20251231T235959Z)
+func parseRRuleDate(value string) (time.Time, error) {
+	if len(value) == len("20060102") {
+		day, err := time.ParseInLocation("20060102", value, BusinessLocation)
+		if err != nil {
+			return time.Time{}, err
+		}
+		// Data sem hora inclui o dia inteiro
+		return day.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Parse("20060102T150405Z", value)
+}
+
+// Validate confere a combinação de parâmetros e aplica os padrões
+func (r *Recurrence) Validate() error {
+	if r.Interval == 0 {
+		r.Interval = 1
+	}
+	if r.Interval < 0 || r.Count < 0 {
+		return ErrInvalidRecurrence
+	}
+	if r.Count > 0 && r.Until != nil {
+		// A RFC 5545 não permite COUNT e UNTIL juntos
+		return fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", ErrInvalidRecurrence)
+	}
+
+	switch r.Frequency {
+	case FrequencyDaily:
+		if len(r.ByDay) > 0 || r.ByMonthDay != 0 {
+			return fmt.Errorf("%w: DAILY does not accept BYDAY or BYMONTHDAY", ErrInvalidRecurrence)
+		}
+	case FrequencyWeekly:
+		if r.ByMonthDay != 0 {
+			return fmt.Errorf("%w: WEEKLY does not accept BYMONTHDAY", ErrInvalidRecurrence)
+		}
+	case FrequencyMonthly:
+		if len(r.ByDay) > 0 {
+			return fmt.Errorf("%w: MONTHLY does not accept BYDAY", ErrInvalidRecurrence)
+		}
+		if r.ByMonthDay != LastDayOfMonth && (r.ByMonthDay < 0 || r.ByMonthDay > 31) {
+			return fmt.Errorf("%w: BYMONTHDAY must be between 1 and 31 (or -1)", ErrInvalidRecurrence)
+		}
+	default:
+		return fmt.Errorf("%w: FREQ must be DAILY, WEEKLY or MONTHLY", ErrInvalidRecurrence)
+	}
+	return nil
+}
+
+// String devolve a regra no formato RRULE canônico (é o que fica salvo no banco)
+func (r *Recurrence) String() string {
+	parts := []string{"FREQ=" + r.Frequency}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			for code, weekday := range weekdayCodes {
+				if weekday == day {
+					codes = append(codes, code)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.ByMonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.ByMonthDay))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next devolve a primeira ocorrência estritamente depois de 'after', para uma série iniciada em 'start'.
+// Retorna false se a série terminou pelo UNTIL (o COUNT é controlado por quem conta as ocorrências).
+// A regra é aplicada no fuso de negócio: dia do mês, dia da semana e horário são os de São Paulo,
+// qualquer que seja o fuso em que 'start' chegou (o banco devolve UTC).
+func (r *Recurrence) Next(start, after time.Time) (time.Time, bool) {
+	start = start.In(BusinessLocation)
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		for _, candidate := range r.candidates(start, period) {
+			if candidate.Before(start) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return time.Time{}, false
+			}
+			if candidate.After(after) {
+				return candidate, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// First devolve a primeira ocorrência da série (a partir de start, inclusive)
+func (r *Recurrence) First(start time.Time) (time.Time, bool) {
+	return r.Next(start, start.Add(-time.Nanosecond))
+}
+
+// candidates gera as ocorrências de um período (dia, semana ou mês), em ordem crescente
+func (r *Recurrence) candidates(start time.Time, period int) []time.Time {
+	hour, minute, second := start.Clock()
+	year, month, day := start.Date()
+	loc := BusinessLocation
+	step := period * r.Interval
+
+	switch r.Frequency {
+	case FrequencyDaily:
+		return []time.Time{time.Date(year, month, day+step, hour, minute, second, 0, loc)}
+
+	case FrequencyWeekly:
+		// Semanas começam na segunda-feira (WKST=MO, padrão da RFC)
+		mondayOffset := (int(start.Weekday()) + 6) % 7
+		weekStart := day - mondayOffset + 7*step
+
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+		result := make([]time.Time, 0, len(days))
+		for _, weekday := range days {
+			offset := (int(weekday) + 6) % 7
+			result = append(result, time.Date(year, month, weekStart+offset, hour, minute, second, 0, loc))
+		}
+		sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+		return result
+
+	case FrequencyMonthly:
+		firstOfMonth := time.Date(year, month+time.Month(step), 1, hour, minute, second, 0, loc)
+		daysInMonth := firstOfMonth.AddDate(0, 1, -1).Day()
+
+		monthDay := r.ByMonthDay
+		if monthDay == 0 {
+			monthDay = day
+		}
+		// Dia 31 em um mês de 30 dias (ou -1) vira o último dia do mês
+		if monthDay == LastDayOfMonth || monthDay > daysInMonth {
+			monthDay = daysInMonth
+		}
+		return []time.Time{firstOfMonth.AddDate(0, 0, monthDay-1)}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    string // Forma canônica (String)
+		wantErr bool
+	}{
+		{name: "mensal com prefixo", rule: "RRULE:FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12", want: "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12"},
+		{name: "minúsculas", rule: "freq=weekly;byday=mo", want: "FREQ=WEEKLY;BYDAY=MO"},
+		{name: "intervalo 1 é o padrão", rule: "FREQ=DAILY;INTERVAL=1", want: "FREQ=DAILY"},
+		{name: "último dia do mês", rule: "FREQ=MONTHLY;BYMONTHDAY=-1", want: "FREQ=MONTHLY;BYMONTHDAY=-1"},
+		{name: "UNTIL em data-hora UTC", rule: "FREQ=DAILY;UNTIL=20261231T120000Z", want: "FREQ=DAILY;UNTIL=20261231T120000Z"},
+		{name: "UNTIL em data vai até o fim do dia em BRT", rule: "FREQ=DAILY;UNTIL=20261231", want: "FREQ=DAILY;UNTIL=20270101T025959Z"},
+		{name: "vazia", rule: "", wantErr: true},
+		{name: "sem FREQ", rule: "COUNT=3", wantErr: true},
+		{name: "frequência não suportada", rule: "FREQ=YEARLY", wantErr: true},
+		{name: "parâmetro não suportado", rule: "FREQ=DAILY;BYHOUR=10", wantErr: true},
+		{name: "COUNT e UNTIL juntos", rule: "FREQ=DAILY;COUNT=3;UNTIL=20261231", wantErr: true},
+		{name: "BYDAY fora do semanal", rule: "FREQ=MONTHLY;BYDAY=MO", wantErr: true},
+		{name: "BYMONTHDAY fora do mensal", rule: "FREQ=WEEKLY;BYMONTHDAY=5", wantErr: true},
+		{name: "BYMONTHDAY inválido", rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "dia da semana inválido", rule: "FREQ=WEEKLY;BYDAY=XX", wantErr: true},
+		{name: "intervalo negativo", rule: "FREQ=DAILY;INTERVAL=-2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRecurrence) {
+					t.Fatalf("ParseRRule(%q) error = %v, want ErrInvalidRecurrence", tt.rule, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRRule(%q) error = %v", tt.rule, err)
+			}
+			if got := r.String(); got != tt.want {
+				t.Errorf("ParseRRule(%q).String() = %q, want %q", tt.rule, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		start string   // RFC3339
+		want  []string // RFC3339 no fuso de negócio
+	}{
+		{
+			name:  "diária a cada 2 dias",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: "2026-03-01T09:00:00-03:00",
+			want:  []string{"2026-03-01T09:00:00-03:00", "2026-03-03T09:00:00-03:00", "2026-03-05T09:00:00-03:00"},
+		},
+		{
+			name:  "semanal em vários dias, início no meio da semana",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,TH",
+			start: "2026-01-07T10:00:00-03:00", // Quarta-feira
+			want:  []string{"2026-01-08T10:00:00-03:00", "2026-01-12T10:00:00-03:00", "2026-01-15T10:00:00-03:00", "2026-01-19T10:00:00-03:00"},
+		},
+		{
+			name:  "quinzenal no dia do início",
+			rule:  "FREQ=WEEKLY;INTERVAL=2",
+			start: "2026-01-05T10:00:00-03:00",
+			want:  []string{"2026-01-05T10:00:00-03:00", "2026-01-19T10:00:00-03:00", "2026-02-02T10:00:00-03:00"},
+		},
+		{
+			name:  "dia 31 cai no último dia dos meses curtos",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=31",
+			start: "2026-01-31T08:00:00-03:00",
+			want:  []string{"2026-01-31T08:00:00-03:00", "2026-02-28T08:00:00-03:00", "2026-03-31T08:00:00-03:00", "2026-04-30T08:00:00-03:00"},
+		},
+		{
+			name:  "último dia do mês em ano bissexto",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start: "2028-01-10T08:00:00-03:00",
+			want:  []string{"2028-01-31T08:00:00-03:00", "2028-02-29T08:00:00-03:00", "2028-03-31T08:00:00-03:00"},
+		},
+		{
+			name:  "dia do mês é o de São Paulo mesmo com início em UTC",
+			rule:  "FREQ=MONTHLY",
+			start: "2026-02-01T02:30:00Z", // 31/01 às 23:30 em BRT
+			want:  []string{"2026-01-31T23:30:00-03:00", "2026-02-28T23:30:00-03:00", "2026-03-31T23:30:00-03:00"},
+		},
+		{
+			name:  "dia da semana é o de São Paulo mesmo com início em UTC",
+			rule:  "FREQ=WEEKLY",
+			start: "2026-01-06T01:00:00Z", // Segunda 05/01 às 22:00 em BRT
+			want:  []string{"2026-01-05T22:00:00-03:00", "2026-01-12T22:00:00-03:00"},
+		},
+		{
+			name:  "sem horário de verão: o horário não muda em novembro nem em fevereiro",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=15",
+			start: "2026-10-15T10:00:00-03:00",
+			want:  []string{"2026-10-15T10:00:00-03:00", "2026-11-15T10:00:00-03:00", "2026-12-15T10:00:00-03:00", "2027-01-15T10:00:00-03:00", "2027-02-15T10:00:00-03:00", "2027-03-15T10:00:00-03:00"},
+		},
+		{
+			name:  "UNTIL em data inclui o último dia inteiro",
+			rule:  "FREQ=DAILY;UNTIL=20260103",
+			start: "2026-01-01T23:00:00-03:00",
+			want:  []string{"2026-01-01T23:00:00-03:00", "2026-01-02T23:00:00-03:00", "2026-01-03T23:00:00-03:00"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("ParseRRule(%q) error = %v", tt.rule, err)
+			}
+			start := mustParseTime(t, tt.start)
+
+			// Uma ocorrência a mais que o esperado: com UNTIL, a série precisa terminar ali
+			var got []time.Time
+			next, ok := r.First(start)
+			for ok && len(got) <= len(tt.want) {
+				got = append(got, next)
+				next, ok = r.Next(start, next)
+			}
+			if r.Until != nil && len(got) > len(tt.want) {
+				t.Fatalf("série com UNTIL continuou depois de %s: %s", tt.want[len(tt.want)-1], got[len(tt.want)])
+			}
+			if len(got) < len(tt.want) {
+				t.Fatalf("got %d occurrences, want %d", len(got), len(tt.want))
+			}
+
+			for i, want := range tt.want {
+				wantTime := mustParseTime(t, want)
+				if !got[i].Equal(wantTime) {
+					t.Errorf("occurrence %d = %s, want %s", i, got[i].In(BusinessLocation).Format(time.RFC3339), want)
+				}
+				if got[i].Location() != BusinessLocation {
+					t.Errorf("occurrence %d location = %s, want %s", i, got[i].Location(), BusinessLocation)
+				}
+			}
+		})
+	}
+}
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("time.Parse(%q) error = %v", value, err)
+	}
+	return parsed
+}
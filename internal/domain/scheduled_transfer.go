@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+)
+
+// Status de uma transferência agendada
+const (
+	ScheduleStatusPending    = "pending"
+	ScheduleStatusProcessing = "processing" // Reservada por um scheduler
+	ScheduleStatusExecuted   = "executed"
+	ScheduleStatusFailed     = "failed"
+	ScheduleStatusCancelled  = "cancelled"
+)
+
+// ScheduledTransfer é uma transferência guardada para ser executada em ExecuteAt
+type ScheduledTransfer struct {
+	ID            string
+	FromWalletID  int64
+	ToWalletID    int64
+	Amount        int64
+	Currency      Currency
+	ExecuteAt     time.Time
+	Status        string
+	Attempts      int32
+	TransactionID string // Transferência gerada na execução
+	FailureReason string
+	ExecutedAt    *time.Time
+	NextAttemptAt *time.Time // Nova tentativa após erro transitório: o scheduler espera até aqui
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// IdempotencyKey é determinística: toda tentativa de executar o mesmo agendamento
+// usa a mesma chave, então um retry nunca paga duas vezes.
+func (s *ScheduledTransfer) IdempotencyKey() string {
+	return "scheduled-transfer:" + s.ID
+}
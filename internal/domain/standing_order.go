@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"time"
+)
+
+// Status de uma ordem permanente
+const (
+	StandingOrderStatusActive    = "active"
+	StandingOrderStatusPaused    = "paused"
+	StandingOrderStatusCancelled = "cancelled"
+	StandingOrderStatusCompleted = "completed" // Atingiu COUNT ou UNTIL
+)
+
+// Política para ocorrências sem saldo suficiente
+const (
+	InsufficientFundsSkip  = "skip"  // Pula a ocorrência e segue para a próxima
+	InsufficientFundsRetry = "retry" // Tenta de novo a cada RetryInterval, até MaxRetries vezes
+)
+
+// Resultado de cada ocorrência executada
+const (
+	OccurrenceStatusExecuted = "executed"
+	OccurrenceStatusSkipped  = "skipped"
+	OccurrenceStatusFailed   = "failed"
+)
+
+// StandingOrder é uma transferência recorrente (ex: todo dia 5, 1.500,00 para a carteira X)
+type StandingOrder struct {
+	ID           string
+	FromWalletID int64
+	ToWalletID   int64
+	Amount       int64
+	Currency     Currency
+	Recurrence   *Recurrence
+	StartAt      time.Time
+
+	InsufficientFundsPolicy string
+	MaxRetries              int32
+	RetryInterval           time.Duration
+
+	Status            string
+	OccurrencesCount  int32      // Ocorrências consumidas (executadas, puladas ou falhas)
+	NextOccurrenceAt  *time.Time // Data da próxima ocorrência (nil quando a ordem termina)
+	NextRunAt         *time.Time // Quando o executor deve tentar (difere da ocorrência durante retries)
+	Attempts          int32      // Tentativas da ocorrência atual
+	LastFailureReason string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// StandingOrderOccurrence é o histórico de uma ocorrência da ordem
+type StandingOrderOccurrence struct {
+	ID              int64
+	StandingOrderID string
+	OccurrenceAt    time.Time
+	Status          string
+	TransactionID   string
+	FailureReason   string
+	CreatedAt       time.Time
+}
+
+// IdempotencyKey é determinística por ocorrência: retries da mesma ocorrência nunca pagam duas vezes
+func (o *StandingOrder) IdempotencyKey(occurrence time.Time) string {
+	return "standing-order:" + o.ID + ":" + occurrence.UTC().Format("20060102T150405Z")
+}
+
+// IsActive indica se o executor deve processar a ordem
+func (o *StandingOrder) IsActive() bool {
+	return o.Status == StandingOrderStatusActive
+}
+
+// ValidatePolicy confere a política de saldo insuficiente
+func (o *StandingOrder) ValidatePolicy() error {
+	if o.InsufficientFundsPolicy != InsufficientFundsSkip && o.InsufficientFundsPolicy != InsufficientFundsRetry {
+		return ErrInvalidOrderPolicy
+	}
+	if o.MaxRetries < 0 || o.RetryInterval < time.Second {
+		return ErrInvalidOrderPolicy
+	}
+	return nil
+}
+
+// Advance consome a ocorrência atual e agenda a próxima (ou encerra a ordem)
+func (o *StandingOrder) Advance() {
+	o.OccurrencesCount++
+	o.Attempts = 0
+
+	var next time.Time
+	ok := o.NextOccurrenceAt != nil
+	if ok {
+		next, ok = o.Recurrence.Next(o.StartAt, *o.NextOccurrenceAt)
+	}
+	if !ok || (o.Recurrence.Count > 0 && int(o.OccurrencesCount) >= o.Recurrence.Count) {
+		o.Status = StandingOrderStatusCompleted
+		o.NextOccurrenceAt = nil
+		o.NextRunAt = nil
+		return
+	}
+	o.NextOccurrenceAt = &next
+	o.NextRunAt = &next
+}
+
+// ScheduleFrom reposiciona a ordem na primeira ocorrência a partir de 'from' (inclusive).
+// Usado na criação e ao retomar uma ordem pausada: ocorrências perdidas na pausa não são executadas.
+func (o *StandingOrder) ScheduleFrom(from time.Time) {
+	o.Attempts = 0
+
+	next, ok := o.Recurrence.First(o.StartAt)
+	if ok && next.Before(from) {
+		next, ok = o.Recurrence.Next(o.StartAt, from.Add(-time.Nanosecond))
+	}
+	if !ok || (o.Recurrence.Count > 0 && int(o.OccurrencesCount) >= o.Recurrence.Count) {
+		o.Status = StandingOrderStatusCompleted
+		o.NextOccurrenceAt = nil
+		o.NextRunAt = nil
+		return
+	}
+	o.NextOccurrenceAt = &next
+	o.NextRunAt = &next
+}
@@ -1,13 +1,122 @@
 package domain
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
+
+// Status de uma transação
+const (
+	TransactionStatusCompleted         = "completed"
+	TransactionStatusFailed            = "failed"
+	TransactionStatusPartiallyRefunded = "partially_refunded"
+	TransactionStatusRefunded          = "refunded"
+)
+
+// Tipos de transação
+const (
+	TransactionKindTransfer = "transfer" // Origem -> destino (com câmbio, passando pelas posições da casa)
+	TransactionKindJournal  = "journal"  // N pernas balanceadas, sem origem/destino únicos
+	// Entrada e saída de dinheiro da instituição, contra a carteira de liquidação da moeda
+	TransactionKindDeposit    = "deposit"    // Liquidação -> carteira
+	TransactionKindWithdrawal = "withdrawal" // Carteira -> liquidação
+)
+
+// IdempotencyScopeInternal é o escopo das chaves geradas pelos jobs (agendamentos, ordens permanentes,
+// juros). O escopo de um cliente é sempre "<cliente> <rota>": nenhuma requisição alcança as chaves internas.
+const IdempotencyScopeInternal = "internal"
+
+// Direções de uma transação do ponto de vista de uma carteira
+const (
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
 
 type Transaction struct {
 	ID             string
-	FromWalletID   int64
-	ToWalletID     int64
+	Kind           string // Vazio = transfer
+	FromWalletID   int64  // Zero em lançamentos journal
+	ToWalletID     int64  // Zero em lançamentos journal
 	Amount         int64
+	Currency       Currency
 	Status         string
 	IdempotencyKey *string
-	CreatedAt      time.Time
+	// IdempotencyScope é o escopo em que IdempotencyKey é única: o cliente e a rota que a enviaram
+	IdempotencyScope string
+	Description      string
+	FeeAmount        int64 // Tarifa cobrada do remetente, além de Amount
+	CreatedAt        time.Time
+
+	// Preenchidos apenas em transferências com câmbio
+	DestinationAmount   int64
+	DestinationCurrency Currency
+	FXRate              *big.Rat
+	FXQuoteID           string
+
+	// Estornos: a original acumula RefundedAmount; o estorno aponta para ela em OriginalTransactionID
+	RefundedAmount        int64
+	OriginalTransactionID string
+}
+
+// Matches confere se a transação move o que se espera: uma chave já usada só é aceita como
+// resultado de uma tentativa anterior se origem, destino, valor e moeda forem os mesmos
+func (t *Transaction) Matches(fromWalletID, toWalletID int64, amount Money) bool {
+	return t.FromWalletID == fromWalletID && t.ToWalletID == toWalletID && t.Amount == amount.Amount && t.Currency == amount.Currency
+}
+
+// IsTransfer indica se a transação é uma transferência entre carteiras de clientes
+func (t *Transaction) IsTransfer() bool {
+	return t.Kind == "" || t.Kind == TransactionKindTransfer
+}
+
+// IsJournal indica se a transação é um lançamento de N pernas (as pernas estão nas partidas)
+func (t *Transaction) IsJournal() bool {
+	return t.Kind == TransactionKindJournal
+}
+
+// IsRefund indica se a transação é o estorno de outra
+func (t *Transaction) IsRefund() bool {
+	return t.OriginalTransactionID != ""
+}
+
+// RefundableAmount é quanto ainda pode ser estornado
+func (t *Transaction) RefundableAmount() int64 {
+	return t.Amount - t.RefundedAmount
+}
+
+// ApplyRefund acumula um estorno e atualiza o status da transação original
+func (t *Transaction) ApplyRefund(amount int64) error {
+	if amount <= 0 {
+		return ErrInvalidAmount
+	}
+	if amount > t.RefundableAmount() {
+		return ErrRefundExceedsAmount
+	}
+	t.RefundedAmount += amount
+	if t.RefundedAmount == t.Amount {
+		t.Status = TransactionStatusRefunded
+	} else {
+		t.Status = TransactionStatusPartiallyRefunded
+	}
+	return nil
+}
+
+// IsCrossCurrency indica se a transação converteu moedas (via cotação)
+func (t *Transaction) IsCrossCurrency() bool {
+	return t.FXQuoteID != ""
+}
+
+// WalletTransaction é uma linha do extrato: a transação vista por uma carteira específica.
+type WalletTransaction struct {
+	Transaction
+	EntryID      int64 // ID da partida, usado como cursor da paginação
+	SignedAmount int64 // Negativo = saída, Positivo = entrada
+}
+
+// Direction indica se o dinheiro entrou ou saiu da carteira
+func (t *WalletTransaction) Direction() string {
+	if t.SignedAmount < 0 {
+		return DirectionOut
+	}
+	return DirectionIn
 }
@@ -7,18 +7,132 @@ import (
 // Wallet representa a carteira do usuário.
 // Clean Architecture: Esta entidade não sabe o que é JSON nem SQL.
 type Wallet struct {
-	ID        int64
-	Balance   int64
-	Version   int32 // Para controle de concorrência otimista (se necessário)
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID      int64
+	Balance int64 // Na menor unidade da moeda (centavos para BRL)
+	// HeldAmount é a soma das autorizações ativas: faz parte do saldo, mas não pode ser gasto
+	HeldAmount int64
+	// OverdraftLimit é a linha de crédito: o saldo pode ficar negativo até -OverdraftLimit
+	OverdraftLimit int64
+	Currency       Currency
+	// SystemCode identifica carteiras da própria instituição (ex: "fx_position").
+	// Vazio para carteiras de clientes.
+	SystemCode string
+	// Status controla o que a carteira pode fazer (ver CanDebit/CanCredit)
+	Status string
+	// CustomerID é o titular. nil para carteiras de sistema (e as criadas antes do cadastro de clientes).
+	CustomerID *int64
+	Version    int32 // Para controle de concorrência otimista (se necessário)
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// WalletLimitChange é o registro de auditoria de uma alteração do limite de cheque especial
+type WalletLimitChange struct {
+	ID            int64
+	WalletID      int64
+	PreviousLimit int64
+	NewLimit      int64
+	ChangedBy     string
+	Reason        string
+	CreatedAt     time.Time
 }
 
 // Métodos de domínio (Lógica pura)
 
+// Ciclo de vida da carteira
+const (
+	WalletStatusActive = "active"
+	WalletStatusFrozen = "frozen" // Recebe, mas não envia
+	WalletStatusClosed = "closed" // Estado final: não envia nem recebe
+)
+
+// Códigos das carteiras de sistema (uma por moeda)
+const (
+	SystemWalletFXPosition = "fx_position"
+	SystemWalletFeeRevenue = "fee_revenue" // Receita de tarifas (padrão das políticas)
+	SystemWalletSettlement = "settlement"  // Contrapartida de depósitos e saques
+)
+
+// IsSystem indica se a carteira pertence à instituição e não a um cliente
+func (w *Wallet) IsSystem() bool {
+	return w.SystemCode != ""
+}
+
+// CanDebit indica se a carteira pode enviar dinheiro (ou reservar saldo)
+func (w *Wallet) CanDebit() error {
+	switch w.Status {
+	case WalletStatusFrozen:
+		return ErrWalletFrozen
+	case WalletStatusClosed:
+		return ErrWalletClosed
+	}
+	return nil
+}
+
+// CanCredit indica se a carteira pode receber dinheiro. Carteiras congeladas continuam recebendo.
+func (w *Wallet) CanCredit() error {
+	if w.Status == WalletStatusClosed {
+		return ErrWalletClosed
+	}
+	return nil
+}
+
+// Freeze bloqueia os débitos de uma carteira ativa
+func (w *Wallet) Freeze() error {
+	if w.Status != WalletStatusActive {
+		return ErrInvalidWalletTransition
+	}
+	w.Status = WalletStatusFrozen
+	return nil
+}
+
+// Unfreeze devolve uma carteira congelada ao estado ativo
+func (w *Wallet) Unfreeze() error {
+	if w.Status != WalletStatusFrozen {
+		return ErrInvalidWalletTransition
+	}
+	w.Status = WalletStatusActive
+	return nil
+}
+
+// Close encerra a carteira. Só é permitido com saldo zerado e sem autorizações ativas.
+func (w *Wallet) Close() error {
+	if w.Status == WalletStatusClosed {
+		return ErrInvalidWalletTransition
+	}
+	if w.Balance != 0 || w.HeldAmount != 0 {
+		return ErrWalletNotEmpty
+	}
+	w.Status = WalletStatusClosed
+	return nil
+}
+
+// BalanceMoney retorna o saldo como valor monetário na moeda da carteira
+func (w *Wallet) BalanceMoney() Money {
+	return Money{Amount: w.Balance, Currency: w.Currency}
+}
+
+// AvailableBalance é o saldo que pode ser gasto (balance - autorizações ativas + limite de cheque especial)
+func (w *Wallet) AvailableBalance() int64 {
+	return w.Balance - w.HeldAmount + w.OverdraftLimit
+}
+
+// SetOverdraftLimit altera a linha de crédito. O novo limite precisa cobrir o saldo negativo atual,
+// senão a carteira ficaria violando a constraint do banco.
+func (w *Wallet) SetOverdraftLimit(limit int64) error {
+	if limit < 0 {
+		return ErrInvalidOverdraftLimit
+	}
+	if w.Balance < -limit {
+		return ErrLimitBelowBalance
+	}
+	w.OverdraftLimit = limit
+	return nil
+}
+
 // HasSufficientFunds valida se a carteira pode pagar antes mesmo de tocar no DB
 func (w *Wallet) HasSufficientFunds(amount int64) bool {
-	return w.Balance >= amount
+	return w.AvailableBalance() >= amount
 }
 
 func (w *Wallet) Debit(amount int64) error {
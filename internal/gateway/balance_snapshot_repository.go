@@ -0,0 +1,20 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// BalanceSnapshotRepository persiste as fotografias de saldo de fim de dia.
+// As datas são dias do calendário de Brasília (domain.BusinessDate).
+type BalanceSnapshotRepository interface {
+	// CreateForDate fotografa as carteiras com movimento desde a última fotografia e
+	// retorna quantas foram gravadas (reexecutar para a mesma data não duplica)
+	CreateForDate(ctx context.Context, date time.Time, closesAt time.Time) (int64, error)
+	// GetLatest retorna (nil, nil) se a carteira não tem fotografia fechada até 'at'
+	GetLatest(ctx context.Context, walletID int64, at time.Time) (*domain.BalanceSnapshot, error)
+
+	WithTx(tx TransactionObject) BalanceSnapshotRepository
+}
@@ -0,0 +1,22 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// CustomerRepository persiste os clientes (titulares das carteiras)
+type CustomerRepository interface {
+	// Create grava o cliente (domain.ErrDocumentAlreadyExists se o CPF/CNPJ já estiver cadastrado)
+	Create(ctx context.Context, customer *domain.Customer) error
+	GetByID(ctx context.Context, id int64) (*domain.Customer, error)
+	// List devolve os clientes com id maior que afterID, em ordem crescente (paginação por cursor)
+	List(ctx context.Context, afterID int64, limit int32) ([]domain.Customer, error)
+	// Update grava nome, email, telefone e situação do KYC (o documento não muda)
+	Update(ctx context.Context, customer *domain.Customer) error
+	// Delete remove o cliente (domain.ErrCustomerHasWallets se ainda houver carteiras dele)
+	Delete(ctx context.Context, id int64) error
+
+	WithTx(tx TransactionObject) CustomerRepository
+}
@@ -0,0 +1,25 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// EntryRepository persiste as partidas dobradas do livro-razão.
+type EntryRepository interface {
+	Create(ctx context.Context, entry *domain.Entry) error
+	ListByTransaction(ctx context.Context, transactionID string) ([]domain.Entry, error)
+
+	// LedgerBalance reconstrói o saldo da carteira somando todas as suas partidas
+	LedgerBalance(ctx context.Context, walletID int64) (int64, error)
+	// BalanceAt soma as partidas registradas antes de 'at' (saldo da carteira naquele instante)
+	BalanceAt(ctx context.Context, walletID int64, at time.Time) (int64, error)
+	// SumBetween soma as partidas registradas no intervalo [since, until)
+	SumBetween(ctx context.Context, walletID int64, since, until time.Time) (int64, error)
+	// DailyNetAmounts soma as partidas de [since, until) por dia de Brasília (só os dias com movimento)
+	DailyNetAmounts(ctx context.Context, walletID int64, since, until time.Time) ([]domain.DailyNetAmount, error)
+
+	WithTx(tx TransactionObject) EntryRepository
+}
@@ -1,7 +1,52 @@
 package gateway
 
-import "context"
+import (
+	"context"
+	"sync"
+)
 
 type EventPublisher interface {
 	Publish(ctx context.Context, exchange, routingKey string, body interface{}) error
 }
+
+// PendingEvents segura os eventos publicados dentro de uma transação do banco aberta fora dos casos
+// de uso (ex: a do middleware de idempotência) até o Commit dela: um Rollback não pode anunciar
+// dinheiro que não se moveu. Sem Flush, os eventos são descartados.
+type PendingEvents struct {
+	mu      sync.Mutex
+	publish []func(ctx context.Context)
+}
+
+// pendingEventsKey evita colisão de chaves no contexto
+type pendingEventsKey struct{}
+
+// ContextWithPendingEvents faz os publishers segurarem os eventos publicados com o contexto retornado
+func ContextWithPendingEvents(ctx context.Context) (context.Context, *PendingEvents) {
+	pending := &PendingEvents{}
+	return context.WithValue(ctx, pendingEventsKey{}, pending), pending
+}
+
+// PendingEventsFromContext retorna a fila do contexto, ou nil se os eventos podem sair na hora
+func PendingEventsFromContext(ctx context.Context) *PendingEvents {
+	pending, _ := ctx.Value(pendingEventsKey{}).(*PendingEvents)
+	return pending
+}
+
+// Add guarda a publicação de um evento para depois do Commit
+func (p *PendingEvents) Add(publish func(ctx context.Context)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.publish = append(p.publish, publish)
+}
+
+// Flush publica os eventos guardados, na ordem em que foram publicados. Chamado depois do Commit.
+func (p *PendingEvents) Flush(ctx context.Context) {
+	p.mu.Lock()
+	publish := p.publish
+	p.publish = nil
+	p.mu.Unlock()
+
+	for _, fn := range publish {
+		fn(ctx)
+	}
+}
@@ -0,0 +1,19 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// FeePolicyRepository persiste as políticas de tarifa por faixa e moeda
+type FeePolicyRepository interface {
+	// Get retorna domain.ErrFeePolicyNotFound se a faixa não tiver política na moeda
+	Get(ctx context.Context, tier string, currency domain.Currency) (*domain.FeePolicy, error)
+	List(ctx context.Context) ([]domain.FeePolicy, error)
+	Save(ctx context.Context, policy *domain.FeePolicy) error
+	// Delete retorna domain.ErrFeePolicyNotFound se não havia política
+	Delete(ctx context.Context, tier string, currency domain.Currency) error
+
+	WithTx(tx TransactionObject) FeePolicyRepository
+}
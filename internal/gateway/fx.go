@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// FXRateProvider é a fonte das taxas de câmbio.
+// Plugável: tabela estática para desenvolvimento local, API de mercado em produção.
+type FXRateProvider interface {
+	// GetRate retorna domain.ErrRateUnavailable se o par não for cotado
+	GetRate(ctx context.Context, from, to domain.Currency) (*domain.ExchangeRate, error)
+}
+
+// FXQuoteRepository persiste as cotações travadas pelos clientes
+type FXQuoteRepository interface {
+	Create(ctx context.Context, quote *domain.FXQuote) error
+
+	// Lock Pessimista: impede que a mesma cotação seja executada em paralelo
+	GetByIDForUpdate(ctx context.Context, id string) (*domain.FXQuote, error)
+	// MarkUsed consome a cotação. Retorna domain.ErrQuoteAlreadyUsed se já foi usada.
+	MarkUsed(ctx context.Context, id string) error
+
+	WithTx(tx TransactionObject) FXQuoteRepository
+}
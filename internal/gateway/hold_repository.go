@@ -0,0 +1,24 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// HoldRepository persiste as autorizações (reservas de saldo)
+type HoldRepository interface {
+	Create(ctx context.Context, hold *domain.Hold) error
+	GetByID(ctx context.Context, id string) (*domain.Hold, error)
+
+	// Lock Pessimista: captura, cancelamento e expiração não podem correr em paralelo
+	GetByIDForUpdate(ctx context.Context, id string) (*domain.Hold, error)
+	// Close encerra uma autorização ativa com o status, valor capturado e transação do hold.
+	// Retorna domain.ErrHoldNotActive se ela já tiver sido encerrada.
+	Close(ctx context.Context, hold *domain.Hold) error
+	// ListExpired retorna os IDs das autorizações ativas vencidas até 'now'
+	ListExpired(ctx context.Context, now time.Time, limit int32) ([]string, error)
+
+	WithTx(tx TransactionObject) HoldRepository
+}
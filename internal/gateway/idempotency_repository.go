@@ -5,17 +5,99 @@ import (
 	"time"
 )
 
-// Representa o que salvamos no Redis
+// IdempotencyKey identifica uma chave no escopo de quem a enviou: a mesma Idempotency-Key
+// vinda de outro cliente ou enviada para outra rota é outra operação
+type IdempotencyKey struct {
+	ClientID string // Cliente autenticado (ver middleware.IdempotencyConfig.ClientID)
+	Route    string // Método e padrão da rota (ex: "POST /admin/wallets/{id}/deposits")
+	Key      string // Valor do header Idempotency-Key
+}
+
+// Scope é o escopo da chave gravado junto das transações (transactions.idempotency_scope)
+func (k IdempotencyKey) Scope() string {
+	return k.ClientID + " " + k.Route
+}
+
+// idempotencyKeyContextKey evita colisão de chaves no contexto
+type idempotencyKeyContextKey struct{}
+
+// ContextWithIdempotencyKey guarda no contexto a chave da requisição, já com o escopo
+func ContextWithIdempotencyKey(ctx context.Context, key IdempotencyKey) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext retorna a chave guardada pelo middleware de idempotência.
+// Sem o middleware, retorna false.
+func IdempotencyKeyFromContext(ctx context.Context) (IdempotencyKey, bool) {
+	key, ok := ctx.Value(idempotencyKeyContextKey{}).(IdempotencyKey)
+	return key, ok
+}
+
+// Representa a resposta gravada (no Postgres, com cache no Redis)
 type CachedResponse struct {
 	StatusCode int
 	Body       []byte
-	Headers    map[string][]string // bom para headers customizados
+	Headers    map[string][]string // Headers da resposta original (Content-Type, Location...), devolvidos no replay
+	// Fingerprint é o SHA-256 de método, rota e corpo canônico da requisição que gerou a resposta.
+	// Vazio nas respostas gravadas antes da verificação existir (aceitas sem comparar).
+	Fingerprint string
+}
+
+// IdempotencyLock é a reserva "em processamento" de uma chave: só quem tem o Token pode liberá-la
+type IdempotencyLock struct {
+	Token       string // Aleatório, gerado por requisição
+	Fingerprint string // Impressão digital da requisição que está processando
 }
 
 type IdempotencyRepository interface {
-	// Get retorna a resposta cacheada se existir. Erro se não existir.
-	Get(ctx context.Context, key string) (*CachedResponse, error)
+	// Get retorna a resposta cacheada se existir. (nil, nil) se não existir.
+	Get(ctx context.Context, key IdempotencyKey) (*CachedResponse, error)
 
 	// Save armazena a resposta com um TTL (Time To Live)
-	Save(ctx context.Context, key string, response CachedResponse, ttl time.Duration) error
+	Save(ctx context.Context, key IdempotencyKey, response CachedResponse, ttl time.Duration) error
+
+	// Reserve marca a chave como "em processamento" de forma atômica (SET NX) por no máximo 'lease':
+	// se a requisição cair sem liberar, a reserva expira sozinha. Se outra requisição já tem a reserva,
+	// retorna false e a reserva dela (nil se expirou nesse meio tempo).
+	Reserve(ctx context.Context, key IdempotencyKey, lock IdempotencyLock, lease time.Duration) (bool, *IdempotencyLock, error)
+
+	// Release libera a reserva, mas só se ela ainda for de 'lock' (a reserva pode ter expirado e sido tomada)
+	Release(ctx context.Context, key IdempotencyKey, lock IdempotencyLock) error
+
+	// WithTx faz o Save participar da transação da operação (implementações sem banco retornam a si mesmas)
+	WithTx(tx TransactionObject) IdempotencyRepository
+}
+
+const (
+	IdempotencyStatusProcessing = "processing" // Reservada por uma requisição em andamento
+	IdempotencyStatusCompleted  = "completed"  // Com resposta gravada
+)
+
+// IdempotencyRecord é a visão administrativa de uma chave gravada
+type IdempotencyRecord struct {
+	IdempotencyKey
+	Status      string
+	Fingerprint string
+	Response    *CachedResponse // nil enquanto em processamento
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+	ExpiresAt   time.Time
+}
+
+// IdempotencyFilter seleciona as gravações de uma Idempotency-Key.
+// ClientID e Route vazios = qualquer cliente ou rota.
+type IdempotencyFilter struct {
+	Key      string
+	ClientID string
+	Route    string
+}
+
+// IdempotencyAdminRepository é a consulta e o expurgo de chaves (rotas /admin)
+type IdempotencyAdminRepository interface {
+	List(ctx context.Context, filter IdempotencyFilter) ([]IdempotencyRecord, error)
+
+	// Purge apaga as gravações (respostas e reservas em andamento) e retorna as chaves apagadas.
+	// A próxima requisição com a chave executa a operação de novo.
+	Purge(ctx context.Context, filter IdempotencyFilter) ([]IdempotencyKey, error)
 }
@@ -0,0 +1,36 @@
+package gateway
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// InterestRepository persiste a configuração de rendimento, os juros diários e os pagamentos mensais.
+// As datas são dias do calendário de Brasília (domain.BusinessDate).
+type InterestRepository interface {
+	// SaveAccount cria ou reconfigura o rendimento (StartDate e CarryUnits só valem na criação)
+	SaveAccount(ctx context.Context, account *domain.InterestAccount) error
+	// GetAccount retorna domain.ErrInterestNotEnabled se a carteira não rende juros
+	GetAccount(ctx context.Context, walletID int64) (*domain.InterestAccount, error)
+	GetAccountForUpdate(ctx context.Context, walletID int64) (*domain.InterestAccount, error)
+	// ListAccountsForAccrual lista as carteiras que já rendiam na data
+	ListAccountsForAccrual(ctx context.Context, date time.Time) ([]domain.InterestAccount, error)
+	UpdateCarry(ctx context.Context, walletID int64, carryUnits *big.Int) error
+
+	// CreateAccrual retorna false se a carteira já tinha rendimento na data
+	CreateAccrual(ctx context.Context, accrual *domain.InterestAccrual) (bool, error)
+	// ListWalletsWithUnpaidAccruals lista as carteiras com rendimento ainda não pago até a data
+	ListWalletsWithUnpaidAccruals(ctx context.Context, until time.Time) ([]int64, error)
+	SumUnpaidAccruals(ctx context.Context, walletID int64, until time.Time) (*big.Int, error)
+
+	// GetPayout retorna (nil, nil) se o mês ainda não foi pago
+	GetPayout(ctx context.Context, walletID int64, periodStart time.Time) (*domain.InterestPayout, error)
+	// CreatePayout registra o pagamento e vincula a ele o rendimento não pago até periodEnd
+	CreatePayout(ctx context.Context, payout *domain.InterestPayout, periodEnd time.Time) error
+	ListPayouts(ctx context.Context, walletID int64, limit int32) ([]domain.InterestPayout, error)
+
+	WithTx(tx TransactionObject) InterestRepository
+}
@@ -0,0 +1,26 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// LimitRepository persiste a configuração dos limites de movimentação e soma as saídas das carteiras
+type LimitRepository interface {
+	// GetTier retorna domain.ErrLimitTierNotFound se a faixa não existir
+	GetTier(ctx context.Context, code string) (*domain.LimitTier, error)
+	ListTiers(ctx context.Context) ([]domain.LimitTier, error)
+	SaveTier(ctx context.Context, tier *domain.LimitTier) error
+
+	// GetWalletLimits retorna a faixa padrão, sem sobrescritas, para carteiras sem configuração própria
+	GetWalletLimits(ctx context.Context, walletID int64) (*domain.WalletLimits, error)
+	SaveWalletLimits(ctx context.Context, limits *domain.WalletLimits) error
+
+	// OutgoingUsage soma as saídas da carteira em cada janela: transferências, pernas de débito
+	// de lançamentos e saques (estornos não contam).
+	// Dentro da transação, com a carteira travada, o resultado não muda até o Commit.
+	OutgoingUsage(ctx context.Context, walletID int64, windows domain.LimitWindows) (domain.OutgoingUsage, error)
+
+	WithTx(tx TransactionObject) LimitRepository
+}
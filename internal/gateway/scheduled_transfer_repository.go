@@ -0,0 +1,28 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// ScheduledTransferRepository persiste as transferências agendadas
+type ScheduledTransferRepository interface {
+	Create(ctx context.Context, schedule *domain.ScheduledTransfer) error
+	GetByID(ctx context.Context, id string) (*domain.ScheduledTransfer, error)
+	// ListByWallet lista os agendamentos da carteira de origem (status vazio = todos)
+	ListByWallet(ctx context.Context, walletID int64, status string, limit int32) ([]domain.ScheduledTransfer, error)
+	// Cancel só cancela agendamentos pendentes (domain.ErrScheduleNotPending caso contrário)
+	Cancel(ctx context.Context, id string) error
+
+	// ClaimDue reserva (status processing) os agendamentos vencidos até 'now'.
+	// Itens em processing sem atualização desde staleBefore são reservados de novo.
+	ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int32) ([]domain.ScheduledTransfer, error)
+	// Finish grava status, transação gerada, motivo da falha, data de execução e próxima tentativa.
+	// Só grava sobre a reserva que gerou o item (status processing, mesmo Attempts):
+	// domain.ErrScheduleClaimLost se outro scheduler reservou o item de novo depois do lease.
+	Finish(ctx context.Context, schedule *domain.ScheduledTransfer) error
+	// WithTx participa da transação atômica (ex: a do middleware de idempotência)
+	WithTx(tx TransactionObject) ScheduledTransferRepository
+}
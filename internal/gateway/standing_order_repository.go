@@ -0,0 +1,30 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// StandingOrderRepository persiste as ordens permanentes e o histórico das ocorrências
+type StandingOrderRepository interface {
+	Create(ctx context.Context, order *domain.StandingOrder) error
+	GetByID(ctx context.Context, id string) (*domain.StandingOrder, error)
+	// ListByWallet lista as ordens da carteira de origem, das mais recentes para as mais antigas
+	ListByWallet(ctx context.Context, walletID int64, limit int32) ([]domain.StandingOrder, error)
+	// Update grava as alterações do cliente (valor, política, status e próxima execução)
+	Update(ctx context.Context, order *domain.StandingOrder) error
+
+	// ClaimDue reserva até claimedUntil as ordens ativas com execução vencida até 'now'
+	ClaimDue(ctx context.Context, now, claimedUntil time.Time, limit int32) ([]domain.StandingOrder, error)
+	// FinishRun grava o progresso do executor e libera a reserva.
+	// Não sobrescreve o status se o cliente pausou ou cancelou a ordem durante a execução.
+	FinishRun(ctx context.Context, order *domain.StandingOrder) error
+
+	CreateOccurrence(ctx context.Context, occurrence *domain.StandingOrderOccurrence) error
+	// ListOccurrences devolve as ocorrências mais recentes primeiro
+	ListOccurrences(ctx context.Context, orderID string, limit int32) ([]domain.StandingOrderOccurrence, error)
+
+	WithTx(tx TransactionObject) StandingOrderRepository
+}
@@ -2,12 +2,40 @@ package gateway
 
 import (
 	"context"
+	"time"
 
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
 )
 
+// TransactionFilter define os filtros do extrato de uma carteira.
+// Campos nil ou vazios são ignorados.
+type TransactionFilter struct {
+	WalletID     int64
+	AfterEntryID *int64 // Cursor (keyset): retorna apenas partidas mais antigas que esta
+	Direction    string // domain.DirectionIn ou domain.DirectionOut
+	From         *time.Time
+	To           *time.Time
+	MinAmount    *int64 // Compara o valor da transação, sem a tarifa cobrada do remetente
+	MaxAmount    *int64
+	Status       string
+	Limit        int32
+}
+
 type TransactionRepository interface {
 	Create(ctx context.Context, transaction *domain.Transaction) error
+	GetByID(ctx context.Context, id string) (*domain.Transaction, error)
+	// Lock Pessimista: usado pelos estornos para não estornar além do valor original
+	GetByIDForUpdate(ctx context.Context, id string) (*domain.Transaction, error)
+	// UpdateRefund grava o total estornado e o novo status da transação original
+	UpdateRefund(ctx context.Context, transaction *domain.Transaction) error
+	// GetByIdempotencyKey permite ao cliente descobrir se uma transferência já foi efetivada.
+	// A chave só é procurada dentro do escopo (ver IdempotencyKey.Scope).
+	GetByIdempotencyKey(ctx context.Context, scope, key string) (*domain.Transaction, error)
+	// ListByWallet retorna o extrato da carteira, do mais recente para o mais antigo
+	ListByWallet(ctx context.Context, filter TransactionFilter) ([]domain.WalletTransaction, error)
+	// ListStatement retorna as partidas da carteira em [since, until), da mais antiga para a mais recente.
+	// Saldos de abertura antigos vêm com Transaction.ID vazio.
+	ListStatement(ctx context.Context, walletID int64, since, until time.Time) ([]domain.WalletTransaction, error)
 	// WithTx segue o mesmo padrão da Wallet para participar da transação atômica
 	WithTx(tx TransactionObject) TransactionRepository
 }
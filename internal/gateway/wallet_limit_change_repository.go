@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// WalletLimitChangeRepository persiste a trilha de auditoria dos limites de cheque especial
+type WalletLimitChangeRepository interface {
+	Create(ctx context.Context, change *domain.WalletLimitChange) error
+	// ListByWallet retorna as alterações mais recentes primeiro
+	ListByWallet(ctx context.Context, walletID int64, limit int32) ([]*domain.WalletLimitChange, error)
+
+	WithTx(tx TransactionObject) WalletLimitChangeRepository
+}
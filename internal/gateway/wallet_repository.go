@@ -9,14 +9,26 @@ import (
 // WalletRepository define o contrato para persistência de carteiras.
 // O Usecase só interage com isso, sem saber se é Postgres ou MySQL.
 type WalletRepository interface {
-	Create(ctx context.Context, balance int64) (*domain.Wallet, error)
+	// Create abre uma carteira do cliente (domain.ErrCustomerNotFound se ele não existir)
+	Create(ctx context.Context, customerID int64, balance domain.Money) (*domain.Wallet, error)
 	GetByID(ctx context.Context, id int64) (*domain.Wallet, error)
+	// ListByCustomer lista as carteiras do cliente, das mais antigas para as mais novas
+	ListByCustomer(ctx context.Context, customerID int64) ([]domain.Wallet, error)
+	// GetSystemWallet busca a carteira de sistema (ex: posição de câmbio) de uma moeda
+	GetSystemWallet(ctx context.Context, code string, currency domain.Currency) (*domain.Wallet, error)
 
 	// Lock Pessimista: Retorna a wallet travando a linha no banco
 	GetByIDForUpdate(ctx context.Context, id int64) (*domain.Wallet, error)
 	// Métodos Atômicos
 	Debit(ctx context.Context, id int64, amount int64) error
 	Credit(ctx context.Context, id int64, amount int64) error
+	// Hold reserva saldo disponível (domain.ErrInsufficientFunds se não houver). Release devolve a reserva.
+	Hold(ctx context.Context, id int64, amount int64) error
+	Release(ctx context.Context, id int64, amount int64) error
+	// UpdateOverdraftLimit grava o novo limite de cheque especial (a validação fica no domínio)
+	UpdateOverdraftLimit(ctx context.Context, id int64, limit int64) error
+	// UpdateStatus grava o novo status do ciclo de vida (active, frozen, closed)
+	UpdateStatus(ctx context.Context, id int64, status string) error
 
 	// WithTx permite que o repositório participe de uma transação iniciada no nível superior
 	// Retorna uma nova instância do repositório ligada àquela transação.
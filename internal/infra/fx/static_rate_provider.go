@@ -0,0 +1,47 @@
+package fx
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+)
+
+// DefaultRates é a tabela usada em desenvolvimento local (valores ilustrativos).
+// Chave: "ORIGEM/DESTINO". O par inverso é calculado automaticamente.
+var DefaultRates = map[string]string{
+	"USD/BRL": "5.4000",
+	"EUR/BRL": "5.9000",
+	"EUR/USD": "1.0900",
+}
+
+// StaticRateProvider implementa gateway.FXRateProvider a partir de uma tabela fixa
+type StaticRateProvider struct {
+	rates map[string]*big.Rat
+}
+
+func NewStaticRateProvider(table map[string]string) (*StaticRateProvider, error) {
+	rates := make(map[string]*big.Rat, len(table)*2)
+	for pair, value := range table {
+		rate, err := domain.ParseRate(value)
+		if err != nil {
+			return nil, fmt.Errorf("taxa inválida para %s: %w", pair, err)
+		}
+		rates[pair] = rate
+	}
+	return &StaticRateProvider{rates: rates}, nil
+}
+
+func (p *StaticRateProvider) GetRate(_ context.Context, from, to domain.Currency) (*domain.ExchangeRate, error) {
+	if rate, ok := p.rates[string(from)+"/"+string(to)]; ok {
+		return &domain.ExchangeRate{From: from, To: to, Rate: rate}, nil
+	}
+
+	// Par inverso: se temos USD/BRL = 5.40, então BRL/USD = 1/5.40
+	if rate, ok := p.rates[string(to)+"/"+string(from)]; ok {
+		return &domain.ExchangeRate{From: from, To: to, Rate: new(big.Rat).Inv(rate)}, nil
+	}
+
+	return nil, domain.ErrRateUnavailable
+}
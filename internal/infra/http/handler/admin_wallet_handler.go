@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// Operador registrado na auditoria quando o header X-Admin-User não é enviado
+const defaultAdminUser = "admin"
+
+// AdminWalletHandler expõe as operações administrativas sobre carteiras (rotas /admin, protegidas por AdminAuth)
+type AdminWalletHandler struct {
+	updateOverdraftLimitUC      *usecase.UpdateOverdraftLimitUseCase
+	listOverdraftLimitChangesUC *usecase.ListOverdraftLimitChangesUseCase
+	changeWalletStatusUC        *usecase.ChangeWalletStatusUseCase
+}
+
+func NewAdminWalletHandler(
+	updateOverdraftLimitUC *usecase.UpdateOverdraftLimitUseCase,
+	listOverdraftLimitChangesUC *usecase.ListOverdraftLimitChangesUseCase,
+	changeWalletStatusUC *usecase.ChangeWalletStatusUseCase,
+) *AdminWalletHandler {
+	return &AdminWalletHandler{
+		updateOverdraftLimitUC:      updateOverdraftLimitUC,
+		listOverdraftLimitChangesUC: listOverdraftLimitChangesUC,
+		changeWalletStatusUC:        changeWalletStatusUC,
+	}
+}
+
+type UpdateOverdraftLimitRequest struct {
+	OverdraftLimit *int64 `json:"overdraft_limit"`
+	Reason         string `json:"reason"`
+}
+
+// UpdateOverdraftLimit altera o limite de cheque especial (PUT /admin/wallets/{id}/overdraft-limit)
+func (h *AdminWalletHandler) UpdateOverdraftLimit(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	var req UpdateOverdraftLimitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+	if req.OverdraftLimit == nil {
+		respondError(w, http.StatusBadRequest, "overdraft_limit é obrigatório")
+		return
+	}
+
+	output, err := h.updateOverdraftLimitUC.Execute(r.Context(), usecase.UpdateOverdraftLimitInput{
+		WalletID:       walletID,
+		OverdraftLimit: *req.OverdraftLimit,
+		Reason:         req.Reason,
+		ChangedBy:      adminUser(r),
+	})
+	if err != nil {
+		respondOverdraftLimitError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// ListOverdraftLimitChanges devolve o limite atual e a trilha de auditoria (GET /admin/wallets/{id}/overdraft-limit)
+func (h *AdminWalletHandler) ListOverdraftLimitChanges(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	output, err := h.listOverdraftLimitChangesUC.Execute(r.Context(), walletID)
+	if err != nil {
+		respondOverdraftLimitError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+type ChangeWalletStatusRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Freeze bloqueia os débitos da carteira (POST /admin/wallets/{id}/freeze)
+func (h *AdminWalletHandler) Freeze(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, usecase.WalletActionFreeze)
+}
+
+// Unfreeze libera uma carteira congelada (POST /admin/wallets/{id}/unfreeze)
+func (h *AdminWalletHandler) Unfreeze(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, usecase.WalletActionUnfreeze)
+}
+
+// Close encerra a carteira, que precisa estar zerada (POST /admin/wallets/{id}/close)
+func (h *AdminWalletHandler) Close(w http.ResponseWriter, r *http.Request) {
+	h.changeStatus(w, r, usecase.WalletActionClose)
+}
+
+func (h *AdminWalletHandler) changeStatus(w http.ResponseWriter, r *http.Request, action string) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	var req ChangeWalletStatusRequest
+	// Corpo opcional: o motivo só vai para o evento
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.changeWalletStatusUC.Execute(r.Context(), usecase.ChangeWalletStatusInput{
+		WalletID:  walletID,
+		Action:    action,
+		Reason:    req.Reason,
+		ChangedBy: adminUser(r),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWalletNotFound):
+			respondError(w, http.StatusNotFound, "Carteira não encontrada")
+		case errors.Is(err, domain.ErrSystemWallet):
+			respondError(w, http.StatusForbidden, "Carteiras de sistema não podem ter o status alterado")
+		case errors.Is(err, domain.ErrWalletNotEmpty):
+			respondError(w, http.StatusConflict, "Carteira precisa estar com saldo zerado e sem autorizações ativas para ser encerrada")
+		case errors.Is(err, domain.ErrInvalidWalletTransition):
+			respondError(w, http.StatusConflict, "Transição de status inválida para a carteira")
+		default:
+			log.Error().Err(err).Msg("Erro interno ao alterar status da carteira")
+			respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// adminUser identifica o operador (header X-Admin-User) para auditoria e eventos
+func adminUser(r *http.Request) string {
+	if user := r.Header.Get("X-Admin-User"); user != "" {
+		return user
+	}
+	return defaultAdminUser
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code (rotas de limite de cheque especial)
+func respondOverdraftLimitError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira não encontrada")
+	case errors.Is(err, domain.ErrInvalidOverdraftLimit):
+		respondError(w, http.StatusBadRequest, "Limite de cheque especial deve ser zero ou positivo")
+	case errors.Is(err, domain.ErrInvalidLimitReason):
+		respondError(w, http.StatusBadRequest, "Motivo da alteração é obrigatório")
+	case errors.Is(err, domain.ErrSystemWallet):
+		respondError(w, http.StatusForbidden, "Carteiras de sistema não possuem limite de cheque especial")
+	case errors.Is(err, domain.ErrWalletClosed):
+		respondError(w, http.StatusConflict, "Carteira encerrada")
+	case errors.Is(err, domain.ErrLimitBelowBalance):
+		respondError(w, http.StatusConflict, "Novo limite não cobre o saldo negativo atual da carteira")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao alterar limite de cheque especial")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
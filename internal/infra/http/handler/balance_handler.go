@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// BalanceHandler expõe o saldo em data passada e a série diária de saldos, reconstruídos pelo ledger.
+// As fotografias de fim de dia que aceleram as consultas são gravadas pelo cmd/snapshot.
+type BalanceHandler struct {
+	getWalletBalanceUC  *usecase.GetWalletBalanceUseCase
+	getBalanceHistoryUC *usecase.GetBalanceHistoryUseCase
+}
+
+func NewBalanceHandler(
+	getWalletBalanceUC *usecase.GetWalletBalanceUseCase,
+	getBalanceHistoryUC *usecase.GetBalanceHistoryUseCase,
+) *BalanceHandler {
+	return &BalanceHandler{
+		getWalletBalanceUC:  getWalletBalanceUC,
+		getBalanceHistoryUC: getBalanceHistoryUC,
+	}
+}
+
+// GetBalance consulta o saldo num instante (GET /wallets/{id}/balance?as_of=2026-03-03T14:00:00-03:00).
+// Sem fuso no as_of, vale o horário de Brasília; sem as_of, o saldo de agora.
+func (h *BalanceHandler) GetBalance(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	input := usecase.GetWalletBalanceInput{WalletID: walletID}
+	if v := r.URL.Query().Get("as_of"); v != "" {
+		asOf, err := parseAsOf(v)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "as_of inválido (use RFC 3339, ex: 2026-03-03T14:00:00-03:00)")
+			return
+		}
+		input.AsOf = &asOf
+	}
+
+	output, err := h.getWalletBalanceUC.Execute(r.Context(), input)
+	if err != nil {
+		respondBalanceError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// GetHistory devolve o saldo de fim de cada dia (GET /wallets/{id}/balance-history?from=2026-03-01&to=2026-03-31).
+// Padrão: os últimos 30 dias até hoje. Máximo de 366 dias por consulta.
+func (h *BalanceHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	query := r.URL.Query()
+	input := usecase.GetBalanceHistoryInput{WalletID: walletID}
+	if v := query.Get("from"); v != "" {
+		from, err := time.ParseInLocation("2006-01-02", v, domain.BusinessLocation)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "from inválido (use AAAA-MM-DD)")
+			return
+		}
+		input.From = &from
+	}
+	if v := query.Get("to"); v != "" {
+		to, err := time.ParseInLocation("2006-01-02", v, domain.BusinessLocation)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "to inválido (use AAAA-MM-DD)")
+			return
+		}
+		input.To = &to
+	}
+
+	output, err := h.getBalanceHistoryUC.Execute(r.Context(), input)
+	if err != nil {
+		respondBalanceError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// parseAsOf aceita RFC 3339 ou data e hora sem fuso (interpretada no horário de Brasília)
+func parseAsOf(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02T15:04:05", v, domain.BusinessLocation)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code (comum às consultas de saldo e ao extrato)
+func respondBalanceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira não encontrada")
+	case errors.Is(err, domain.ErrInvalidBalanceDate):
+		respondError(w, http.StatusBadRequest, "as_of não pode estar no futuro")
+	case errors.Is(err, domain.ErrInvalidDateRange):
+		respondError(w, http.StatusBadRequest, "Período inválido: from <= to <= hoje, com no máximo 366 dias")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao consultar saldo")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// CashHandler expõe os depósitos (cash-in) e saques (cash-out) via HTTP
+type CashHandler struct {
+	depositUC  *usecase.DepositUseCase
+	withdrawUC *usecase.WithdrawUseCase
+}
+
+func NewCashHandler(depositUC *usecase.DepositUseCase, withdrawUC *usecase.WithdrawUseCase) *CashHandler {
+	return &CashHandler{
+		depositUC:  depositUC,
+		withdrawUC: withdrawUC,
+	}
+}
+
+type CashMovementRequest struct {
+	Amount      int64  `json:"amount"`                // Na menor unidade da moeda
+	Currency    string `json:"currency,omitempty"`    // Opcional (ISO 4217)
+	Description string `json:"description,omitempty"` // Opcional (ex: identificador do Pix/TED)
+}
+
+// Deposit credita a carteira (POST /admin/wallets/{id}/deposits)
+func (h *CashHandler) Deposit(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, h.depositUC.Execute)
+}
+
+// Withdraw debita a carteira (POST /admin/wallets/{id}/withdrawals)
+func (h *CashHandler) Withdraw(w http.ResponseWriter, r *http.Request) {
+	h.handle(w, r, h.withdrawUC.Execute)
+}
+
+func (h *CashHandler) handle(
+	w http.ResponseWriter,
+	r *http.Request,
+	execute func(ctx context.Context, input usecase.CashMovementInput) (*usecase.CashMovementOutput, error),
+) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	var req CashMovementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	amount, err := domain.ParseMoney(req.Amount, req.Currency)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		return
+	}
+
+	input := usecase.CashMovementInput{
+		WalletID:    walletID,
+		Amount:      amount,
+		Description: req.Description,
+	}
+	input.IdempotencyKey, input.IdempotencyScope = requestIdempotencyKey(r)
+
+	output, err := execute(r.Context(), input)
+	if err != nil {
+		// Só o saque consome os limites de movimentação
+		var limitErr *domain.LimitExceededError
+		if errors.As(err, &limitErr) {
+			respondLimitExceeded(w, limitErr)
+			return
+		}
+		switch {
+		case errors.Is(err, domain.ErrWalletNotFound):
+			respondError(w, http.StatusNotFound, "Carteira não encontrada")
+		case errors.Is(err, domain.ErrInvalidAmount):
+			respondError(w, http.StatusBadRequest, "Valor inválido")
+		case errors.Is(err, domain.ErrInsufficientFunds):
+			respondError(w, http.StatusUnprocessableEntity, "Saldo insuficiente")
+		case errors.Is(err, domain.ErrCurrencyMismatch):
+			respondError(w, http.StatusUnprocessableEntity, "Moeda não confere com a da carteira")
+		case errors.Is(err, domain.ErrSystemWallet):
+			respondError(w, http.StatusForbidden, "Carteiras de sistema não podem ser movimentadas")
+		case errors.Is(err, domain.ErrWalletFrozen):
+			respondError(w, http.StatusLocked, "Carteira congelada")
+		case errors.Is(err, domain.ErrWalletClosed):
+			respondError(w, http.StatusConflict, "Carteira encerrada")
+		case errors.Is(err, domain.ErrIdempotencyKey):
+			respondError(w, http.StatusConflict, "Idempotency-Key já utilizada em outra transação")
+		default:
+			log.Error().Err(err).Msg("Erro interno ao processar depósito/saque")
+			respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// CustomerHandler expõe o cadastro de clientes (titulares das carteiras) via HTTP
+type CustomerHandler struct {
+	createCustomerUC      *usecase.CreateCustomerUseCase
+	getCustomerUC         *usecase.GetCustomerUseCase
+	listCustomersUC       *usecase.ListCustomersUseCase
+	updateCustomerUC      *usecase.UpdateCustomerUseCase
+	deleteCustomerUC      *usecase.DeleteCustomerUseCase
+	listCustomerWalletsUC *usecase.ListCustomerWalletsUseCase
+}
+
+func NewCustomerHandler(
+	createCustomerUC *usecase.CreateCustomerUseCase,
+	getCustomerUC *usecase.GetCustomerUseCase,
+	listCustomersUC *usecase.ListCustomersUseCase,
+	updateCustomerUC *usecase.UpdateCustomerUseCase,
+	deleteCustomerUC *usecase.DeleteCustomerUseCase,
+	listCustomerWalletsUC *usecase.ListCustomerWalletsUseCase,
+) *CustomerHandler {
+	return &CustomerHandler{
+		createCustomerUC:      createCustomerUC,
+		getCustomerUC:         getCustomerUC,
+		listCustomersUC:       listCustomersUC,
+		updateCustomerUC:      updateCustomerUC,
+		deleteCustomerUC:      deleteCustomerUC,
+		listCustomerWalletsUC: listCustomerWalletsUC,
+	}
+}
+
+type CreateCustomerRequest struct {
+	Name     string `json:"name"`
+	Document string `json:"document"` // CPF ou CNPJ, com ou sem pontuação
+	Email    string `json:"email"`
+	Phone    string `json:"phone,omitempty"`
+}
+
+// Create cadastra um cliente (POST /customers)
+func (h *CustomerHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateCustomerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.createCustomerUC.Execute(r.Context(), usecase.CreateCustomerInput{
+		Name:     req.Name,
+		Document: req.Document,
+		Email:    req.Email,
+		Phone:    req.Phone,
+	})
+	if err != nil {
+		respondCustomerError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
+
+// List lista os clientes (GET /customers?cursor=...&limit=20)
+func (h *CustomerHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	input := usecase.ListCustomersInput{Cursor: query.Get("cursor")}
+	if v := query.Get("limit"); v != "" {
+		var err error
+		if input.Limit, err = strconv.Atoi(v); err != nil {
+			respondError(w, http.StatusBadRequest, "limit inválido")
+			return
+		}
+	}
+
+	output, err := h.listCustomersUC.Execute(r.Context(), input)
+	if err != nil {
+		respondCustomerError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Get consulta um cliente (GET /customers/{id})
+func (h *CustomerHandler) Get(w http.ResponseWriter, r *http.Request) {
+	customerID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID do cliente inválido")
+		return
+	}
+
+	output, err := h.getCustomerUC.Execute(r.Context(), customerID)
+	if err != nil {
+		respondCustomerError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// UpdateCustomerRequest: campos ausentes não são alterados. O documento não pode ser alterado.
+type UpdateCustomerRequest struct {
+	Name  *string `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+	Phone *string `json:"phone,omitempty"` // "" remove o telefone
+}
+
+// Update altera nome, email ou telefone (PATCH /customers/{id})
+func (h *CustomerHandler) Update(w http.ResponseWriter, r *http.Request) {
+	customerID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID do cliente inválido")
+		return
+	}
+
+	var req UpdateCustomerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.updateCustomerUC.Execute(r.Context(), usecase.UpdateCustomerInput{
+		CustomerID: customerID,
+		Name:       req.Name,
+		Email:      req.Email,
+		Phone:      req.Phone,
+	})
+	if err != nil {
+		respondCustomerError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// UpdateKYC altera a situação da verificação de identidade (PUT /admin/customers/{id}/kyc)
+func (h *CustomerHandler) UpdateKYC(w http.ResponseWriter, r *http.Request) {
+	customerID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID do cliente inválido")
+		return
+	}
+
+	var req struct {
+		Status string `json:"status"` // pending, verified ou rejected
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.updateCustomerUC.Execute(r.Context(), usecase.UpdateCustomerInput{
+		CustomerID: customerID,
+		KYCStatus:  &req.Status,
+	})
+	if err != nil {
+		respondCustomerError(w, err)
+		return
+	}
+
+	log.Info().
+		Int64("customer_id", customerID).
+		Str("kyc_status", output.KYCStatus).
+		Str("admin", adminUser(r)).
+		Msg("Situação de KYC do cliente alterada")
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Delete remove um cliente sem carteiras (DELETE /customers/{id})
+func (h *CustomerHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	customerID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID do cliente inválido")
+		return
+	}
+
+	if err := h.deleteCustomerUC.Execute(r.Context(), customerID); err != nil {
+		respondCustomerError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListWallets lista as carteiras do cliente (GET /customers/{id}/wallets)
+func (h *CustomerHandler) ListWallets(w http.ResponseWriter, r *http.Request) {
+	customerID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID do cliente inválido")
+		return
+	}
+
+	output, err := h.listCustomerWalletsUC.Execute(r.Context(), customerID)
+	if err != nil {
+		respondCustomerError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code (comum a todas as rotas de clientes)
+func respondCustomerError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrCustomerNotFound):
+		respondError(w, http.StatusNotFound, "Cliente não encontrado")
+	case errors.Is(err, domain.ErrInvalidDocument):
+		respondError(w, http.StatusBadRequest, "Documento inválido (informe um CPF ou CNPJ válido)")
+	case errors.Is(err, domain.ErrInvalidCustomerName):
+		respondError(w, http.StatusBadRequest, "Nome é obrigatório (até 200 caracteres)")
+	case errors.Is(err, domain.ErrInvalidEmail):
+		respondError(w, http.StatusBadRequest, "Email inválido")
+	case errors.Is(err, domain.ErrInvalidPhone):
+		respondError(w, http.StatusBadRequest, "Telefone inválido (10 a 15 dígitos)")
+	case errors.Is(err, domain.ErrInvalidKYCStatus):
+		respondError(w, http.StatusBadRequest, "Status de KYC deve ser 'pending', 'verified' ou 'rejected'")
+	case errors.Is(err, domain.ErrInvalidCursor):
+		respondError(w, http.StatusBadRequest, "Cursor inválido")
+	case errors.Is(err, domain.ErrDocumentAlreadyExists):
+		respondError(w, http.StatusConflict, "Já existe um cliente com este documento")
+	case errors.Is(err, domain.ErrCustomerHasWallets):
+		respondError(w, http.StatusConflict, "Cliente possui carteiras e não pode ser removido")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar cliente")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
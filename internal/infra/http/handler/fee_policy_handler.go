@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// FeePolicyHandler expõe a configuração das tarifas de transferência (rotas /admin)
+type FeePolicyHandler struct {
+	listFeePoliciesUC *usecase.ListFeePoliciesUseCase
+	saveFeePolicyUC   *usecase.SaveFeePolicyUseCase
+	deleteFeePolicyUC *usecase.DeleteFeePolicyUseCase
+}
+
+func NewFeePolicyHandler(
+	listFeePoliciesUC *usecase.ListFeePoliciesUseCase,
+	saveFeePolicyUC *usecase.SaveFeePolicyUseCase,
+	deleteFeePolicyUC *usecase.DeleteFeePolicyUseCase,
+) *FeePolicyHandler {
+	return &FeePolicyHandler{
+		listFeePoliciesUC: listFeePoliciesUC,
+		saveFeePolicyUC:   saveFeePolicyUC,
+		deleteFeePolicyUC: deleteFeePolicyUC,
+	}
+}
+
+type SaveFeePolicyRequest struct {
+	Kind            string              `json:"kind"` // flat, percentage ou tiered
+	FlatAmount      int64               `json:"flat_amount"`
+	PercentageBps   int64               `json:"percentage_bps"` // 1 bps = 0,01%
+	MinAmount       *int64              `json:"min_amount"`
+	MaxAmount       *int64              `json:"max_amount"`
+	Brackets        []domain.FeeBracket `json:"brackets"`
+	RevenueWalletID *int64              `json:"revenue_wallet_id"` // Opcional: padrão é a carteira fee_revenue da moeda
+}
+
+// List lista as políticas de tarifa (GET /admin/fee-policies)
+func (h *FeePolicyHandler) List(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listFeePoliciesUC.Execute(r.Context())
+	if err != nil {
+		respondFeePolicyError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Save cria ou substitui a política de uma faixa em uma moeda (PUT /admin/fee-policies/{tier}/{currency})
+func (h *FeePolicyHandler) Save(w http.ResponseWriter, r *http.Request) {
+	var req SaveFeePolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.saveFeePolicyUC.Execute(r.Context(), usecase.SaveFeePolicyInput{
+		Tier:            chi.URLParam(r, "tier"),
+		Currency:        chi.URLParam(r, "currency"),
+		Kind:            req.Kind,
+		FlatAmount:      req.FlatAmount,
+		PercentageBps:   req.PercentageBps,
+		MinAmount:       req.MinAmount,
+		MaxAmount:       req.MaxAmount,
+		Brackets:        req.Brackets,
+		RevenueWalletID: req.RevenueWalletID,
+	})
+	if err != nil {
+		respondFeePolicyError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Delete remove a política: a faixa passa a transferir sem tarifa na moeda (DELETE /admin/fee-policies/{tier}/{currency})
+func (h *FeePolicyHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	err := h.deleteFeePolicyUC.Execute(r.Context(), usecase.DeleteFeePolicyInput{
+		Tier:     chi.URLParam(r, "tier"),
+		Currency: chi.URLParam(r, "currency"),
+	})
+	if err != nil {
+		respondFeePolicyError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code
+func respondFeePolicyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrFeePolicyNotFound):
+		respondError(w, http.StatusNotFound, "Política de tarifa não encontrada")
+	case errors.Is(err, domain.ErrLimitTierNotFound):
+		respondError(w, http.StatusNotFound, "Faixa de limites não encontrada")
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira de receita não encontrada")
+	case errors.Is(err, domain.ErrUnsupportedCurrency):
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+	case errors.Is(err, domain.ErrInvalidFeePolicy):
+		respondError(w, http.StatusBadRequest, "Política de tarifa inválida (confira o tipo e os valores)")
+	case errors.Is(err, domain.ErrInvalidRevenueWallet):
+		respondError(w, http.StatusUnprocessableEntity, "A carteira de receita precisa ser uma carteira de sistema na moeda da política")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar política de tarifa")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
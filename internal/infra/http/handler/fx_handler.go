@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/rs/zerolog/log"
+)
+
+// FXHandler expõe as cotações de câmbio via HTTP
+type FXHandler struct {
+	createQuoteUC *usecase.CreateFXQuoteUseCase
+}
+
+func NewFXHandler(createQuoteUC *usecase.CreateFXQuoteUseCase) *FXHandler {
+	return &FXHandler{
+		createQuoteUC: createQuoteUC,
+	}
+}
+
+type CreateFXQuoteRequest struct {
+	SourceCurrency      string `json:"source_currency"`
+	DestinationCurrency string `json:"destination_currency"`
+	SourceAmount        int64  `json:"source_amount"` // Na menor unidade da moeda de origem
+}
+
+// CreateQuote trava uma cotação (POST /fx/quotes)
+func (h *FXHandler) CreateQuote(w http.ResponseWriter, r *http.Request) {
+	var req CreateFXQuoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.createQuoteUC.Execute(r.Context(), usecase.CreateFXQuoteInput{
+		SourceCurrency:      req.SourceCurrency,
+		DestinationCurrency: req.DestinationCurrency,
+		SourceAmount:        req.SourceAmount,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUnsupportedCurrency):
+			respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		case errors.Is(err, domain.ErrInvalidAmount):
+			respondError(w, http.StatusBadRequest, "Valor inválido")
+		case errors.Is(err, domain.ErrRateUnavailable):
+			respondError(w, http.StatusUnprocessableEntity, "Cotação indisponível para o par de moedas")
+		default:
+			log.Error().Err(err).Msg("Erro ao criar cotação")
+			respondError(w, http.StatusInternalServerError, "Erro interno")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
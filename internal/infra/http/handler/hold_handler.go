@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// HoldHandler expõe as autorizações (reserva, captura e cancelamento) via HTTP
+type HoldHandler struct {
+	createHoldUC  *usecase.CreateHoldUseCase
+	getHoldUC     *usecase.GetHoldUseCase
+	captureHoldUC *usecase.CaptureHoldUseCase
+	voidHoldUC    *usecase.VoidHoldUseCase
+}
+
+func NewHoldHandler(
+	createHoldUC *usecase.CreateHoldUseCase,
+	getHoldUC *usecase.GetHoldUseCase,
+	captureHoldUC *usecase.CaptureHoldUseCase,
+	voidHoldUC *usecase.VoidHoldUseCase,
+) *HoldHandler {
+	return &HoldHandler{
+		createHoldUC:  createHoldUC,
+		getHoldUC:     getHoldUC,
+		captureHoldUC: captureHoldUC,
+		voidHoldUC:    voidHoldUC,
+	}
+}
+
+type CreateHoldRequest struct {
+	WalletID   int64  `json:"wallet_id"`
+	ToWalletID int64  `json:"to_wallet_id"`
+	Amount     int64  `json:"amount"`               // Na menor unidade da moeda
+	Currency   string `json:"currency,omitempty"`   // Opcional (ISO 4217)
+	ExpiresAt  string `json:"expires_at,omitempty"` // Opcional (RFC3339). Padrão: 7 dias
+}
+
+type CaptureHoldRequest struct {
+	Amount   int64  `json:"amount,omitempty"`   // Opcional: omitido captura o valor total
+	Currency string `json:"currency,omitempty"` // Opcional (ISO 4217): precisa ser a moeda da autorização
+}
+
+// Create reserva saldo (POST /holds)
+func (h *HoldHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateHoldRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	amount, err := domain.ParseMoney(req.Amount, req.Currency)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		return
+	}
+
+	input := usecase.CreateHoldInput{
+		WalletID:   req.WalletID,
+		ToWalletID: req.ToWalletID,
+		Amount:     amount,
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Parâmetro 'expires_at' inválido (use RFC3339)")
+			return
+		}
+		input.ExpiresAt = &expiresAt
+	}
+
+	output, err := h.createHoldUC.Execute(r.Context(), input)
+	if err != nil {
+		respondHoldError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
+
+// Get consulta uma autorização (GET /holds/{id})
+func (h *HoldHandler) Get(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getHoldUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		respondHoldError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Capture transforma a autorização em transferência (POST /holds/{id}/capture)
+func (h *HoldHandler) Capture(w http.ResponseWriter, r *http.Request) {
+	var req CaptureHoldRequest
+	// Corpo opcional: sem corpo, captura o valor total
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	amount, err := domain.ParseMoney(req.Amount, req.Currency)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		return
+	}
+
+	input := usecase.CaptureHoldInput{
+		HoldID: chi.URLParam(r, "id"),
+		Amount: amount,
+	}
+	input.IdempotencyKey, input.IdempotencyScope = requestIdempotencyKey(r)
+
+	output, err := h.captureHoldUC.Execute(r.Context(), input)
+	if err != nil {
+		respondHoldError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Void cancela a autorização e devolve o saldo (POST /holds/{id}/void)
+func (h *HoldHandler) Void(w http.ResponseWriter, r *http.Request) {
+	output, err := h.voidHoldUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		respondHoldError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code (comum a todas as rotas de holds)
+func respondHoldError(w http.ResponseWriter, err error) {
+	// A captura vira uma transferência e consome os limites de movimentação
+	var limitErr *domain.LimitExceededError
+	if errors.As(err, &limitErr) {
+		respondLimitExceeded(w, limitErr)
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrHoldNotFound):
+		respondError(w, http.StatusNotFound, "Autorização não encontrada")
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira não encontrada")
+	case errors.Is(err, domain.ErrInsufficientFunds):
+		respondError(w, http.StatusUnprocessableEntity, "Saldo disponível insuficiente")
+	case errors.Is(err, domain.ErrInvalidAmount):
+		respondError(w, http.StatusBadRequest, "Valor inválido")
+	case errors.Is(err, domain.ErrInvalidExpiration):
+		respondError(w, http.StatusBadRequest, "Validade da autorização inválida")
+	case errors.Is(err, domain.ErrSameWallet):
+		respondError(w, http.StatusBadRequest, "Carteiras de origem e destino devem ser diferentes")
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		respondError(w, http.StatusUnprocessableEntity, "Moedas das carteiras não conferem")
+	case errors.Is(err, domain.ErrSystemWallet):
+		respondError(w, http.StatusForbidden, "Carteiras de sistema não podem ser movimentadas")
+	case errors.Is(err, domain.ErrWalletFrozen):
+		respondError(w, http.StatusLocked, "Carteira congelada")
+	case errors.Is(err, domain.ErrWalletClosed):
+		respondError(w, http.StatusConflict, "Carteira encerrada")
+	case errors.Is(err, domain.ErrHoldAmountExceeded):
+		respondError(w, http.StatusUnprocessableEntity, "Valor da captura maior que o autorizado")
+	case errors.Is(err, domain.ErrHoldNotActive):
+		respondError(w, http.StatusConflict, "Autorização já encerrada")
+	case errors.Is(err, domain.ErrHoldExpired):
+		respondError(w, http.StatusGone, "Autorização expirada")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar autorização")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
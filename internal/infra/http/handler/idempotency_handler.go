@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/rs/zerolog/log"
+)
+
+// IdempotencyHandler expõe a consulta e o expurgo das chaves de idempotência (rotas /admin)
+type IdempotencyHandler struct {
+	listIdempotencyKeysUC  *usecase.ListIdempotencyKeysUseCase
+	purgeIdempotencyKeysUC *usecase.PurgeIdempotencyKeysUseCase
+}
+
+func NewIdempotencyHandler(
+	listIdempotencyKeysUC *usecase.ListIdempotencyKeysUseCase,
+	purgeIdempotencyKeysUC *usecase.PurgeIdempotencyKeysUseCase,
+) *IdempotencyHandler {
+	return &IdempotencyHandler{
+		listIdempotencyKeysUC:  listIdempotencyKeysUC,
+		purgeIdempotencyKeysUC: purgeIdempotencyKeysUC,
+	}
+}
+
+// List mostra as gravações de uma chave (GET /admin/idempotency-keys?key=...&client_id=...&route=...)
+func (h *IdempotencyHandler) List(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listIdempotencyKeysUC.Execute(r.Context(), idempotencyKeysInput(r))
+	if err != nil {
+		respondIdempotencyError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Purge apaga as gravações de uma chave (DELETE /admin/idempotency-keys?key=...&client_id=...&route=...)
+func (h *IdempotencyHandler) Purge(w http.ResponseWriter, r *http.Request) {
+	output, err := h.purgeIdempotencyKeysUC.Execute(r.Context(), idempotencyKeysInput(r))
+	if err != nil {
+		respondIdempotencyError(w, err)
+		return
+	}
+
+	log.Info().
+		Str("key", r.URL.Query().Get("key")).
+		Int("purged", len(output.Purged)).
+		Str("admin", adminUser(r)).
+		Msg("Chaves de idempotência expurgadas")
+	respondJSON(w, http.StatusOK, output)
+}
+
+func idempotencyKeysInput(r *http.Request) usecase.IdempotencyKeysInput {
+	query := r.URL.Query()
+	return usecase.IdempotencyKeysInput{
+		Key:      query.Get("key"),
+		ClientID: query.Get("client_id"),
+		Route:    query.Get("route"),
+	}
+}
+
+// requestIdempotencyKey retorna a Idempotency-Key da requisição e o escopo (cliente e rota) em que
+// ela é única nas transações. O escopo vem do middleware de idempotência: sem ele, a chave não é usada.
+func requestIdempotencyKey(r *http.Request) (*string, string) {
+	key, ok := gateway.IdempotencyKeyFromContext(r.Context())
+	if !ok || key.Key == "" {
+		return nil, ""
+	}
+	return &key.Key, key.Scope()
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code
+func respondIdempotencyError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrIdempotencyKeyRequired):
+		respondError(w, http.StatusBadRequest, "Parâmetro key é obrigatório")
+	case errors.Is(err, domain.ErrIdempotencyKeyNotFound):
+		respondError(w, http.StatusNotFound, "Chave de idempotência não encontrada")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar chaves de idempotência")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// InterestHandler expõe o rendimento das carteiras de poupança: consulta pelo cliente e configuração pelas rotas /admin.
+// O rendimento diário e o pagamento mensal rodam no cmd/interest.
+type InterestHandler struct {
+	getWalletInterestUC    *usecase.GetWalletInterestUseCase
+	updateWalletInterestUC *usecase.UpdateWalletInterestUseCase
+}
+
+func NewInterestHandler(
+	getWalletInterestUC *usecase.GetWalletInterestUseCase,
+	updateWalletInterestUC *usecase.UpdateWalletInterestUseCase,
+) *InterestHandler {
+	return &InterestHandler{
+		getWalletInterestUC:    getWalletInterestUC,
+		updateWalletInterestUC: updateWalletInterestUC,
+	}
+}
+
+type UpdateWalletInterestRequest struct {
+	AnnualRate string `json:"annual_rate"` // Decimal em string para não passar por float: "0.1075" = 10,75% a.a.
+	DayCount   string `json:"day_count"`   // ACT/365 (padrão), ACT/360 ou ACT/ACT
+}
+
+// GetWalletInterest mostra a taxa, os juros acumulados e os últimos pagamentos (GET /wallets/{id}/interest)
+func (h *InterestHandler) GetWalletInterest(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	output, err := h.getWalletInterestUC.Execute(r.Context(), walletID)
+	if err != nil {
+		respondInterestError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// UpdateWalletInterest ativa o rendimento da carteira ou muda a taxa (PUT /admin/wallets/{id}/interest)
+func (h *InterestHandler) UpdateWalletInterest(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	var req UpdateWalletInterestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.updateWalletInterestUC.Execute(r.Context(), usecase.UpdateWalletInterestInput{
+		WalletID:   walletID,
+		AnnualRate: req.AnnualRate,
+		DayCount:   req.DayCount,
+	})
+	if err != nil {
+		respondInterestError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code
+func respondInterestError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira não encontrada")
+	case errors.Is(err, domain.ErrInterestNotEnabled):
+		respondError(w, http.StatusNotFound, "Carteira não rende juros")
+	case errors.Is(err, domain.ErrInvalidInterestRate):
+		respondError(w, http.StatusBadRequest, "Taxa anual inválida (decimal entre 0 e 1, até 8 casas)")
+	case errors.Is(err, domain.ErrInvalidDayCount):
+		respondError(w, http.StatusBadRequest, "Convenção de dias inválida (use ACT/365, ACT/360 ou ACT/ACT)")
+	case errors.Is(err, domain.ErrSystemWallet):
+		respondError(w, http.StatusForbidden, "Carteiras de sistema não rendem juros")
+	case errors.Is(err, domain.ErrWalletClosed):
+		respondError(w, http.StatusConflict, "Carteira encerrada")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar rendimento")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
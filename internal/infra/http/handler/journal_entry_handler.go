@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/rs/zerolog/log"
+)
+
+// JournalEntryHandler expõe os lançamentos de N pernas via HTTP
+type JournalEntryHandler struct {
+	postJournalEntryUC *usecase.PostJournalEntryUseCase
+}
+
+func NewJournalEntryHandler(postJournalEntryUC *usecase.PostJournalEntryUseCase) *JournalEntryHandler {
+	return &JournalEntryHandler{
+		postJournalEntryUC: postJournalEntryUC,
+	}
+}
+
+type JournalLegRequest struct {
+	WalletID int64  `json:"wallet_id"`
+	Amount   int64  `json:"amount"`             // Negativo = débito, Positivo = crédito
+	Currency string `json:"currency,omitempty"` // Opcional (ISO 4217)
+}
+
+type PostJournalEntryRequest struct {
+	Description string              `json:"description,omitempty"`
+	Legs        []JournalLegRequest `json:"legs"`
+}
+
+// Create grava um lançamento balanceado (POST /journal-entries)
+func (h *JournalEntryHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req PostJournalEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	input := usecase.PostJournalEntryInput{
+		Description: req.Description,
+		Legs:        make([]usecase.JournalLeg, 0, len(req.Legs)),
+	}
+	for _, leg := range req.Legs {
+		amount, err := domain.ParseMoney(leg.Amount, leg.Currency)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Moeda não suportada")
+			return
+		}
+		input.Legs = append(input.Legs, usecase.JournalLeg{WalletID: leg.WalletID, Amount: amount})
+	}
+	input.IdempotencyKey, input.IdempotencyScope = requestIdempotencyKey(r)
+
+	output, err := h.postJournalEntryUC.Execute(r.Context(), input)
+	if err != nil {
+		// As pernas de débito consomem os limites de movimentação da carteira
+		var limitErr *domain.LimitExceededError
+		if errors.As(err, &limitErr) {
+			respondLimitExceeded(w, limitErr)
+			return
+		}
+		switch {
+		case errors.Is(err, domain.ErrInvalidJournal):
+			respondError(w, http.StatusBadRequest, fmt.Sprintf("O lançamento deve ter entre 2 e %d pernas", usecase.MaxJournalLegs))
+		case errors.Is(err, domain.ErrUnbalancedEntries):
+			respondError(w, http.StatusUnprocessableEntity, "As pernas precisam somar zero em cada moeda")
+		case errors.Is(err, domain.ErrInvalidAmount):
+			respondError(w, http.StatusBadRequest, "Pernas não podem ter valor zero")
+		case errors.Is(err, domain.ErrWalletNotFound):
+			respondError(w, http.StatusNotFound, "Carteira não encontrada")
+		case errors.Is(err, domain.ErrInsufficientFunds):
+			respondError(w, http.StatusUnprocessableEntity, "Saldo insuficiente")
+		case errors.Is(err, domain.ErrCurrencyMismatch):
+			respondError(w, http.StatusUnprocessableEntity, "Moeda da perna não confere com a da carteira")
+		case errors.Is(err, domain.ErrSystemWallet):
+			respondError(w, http.StatusForbidden, "Carteiras de sistema não podem ser movimentadas")
+		case errors.Is(err, domain.ErrWalletFrozen):
+			respondError(w, http.StatusLocked, "Carteira congelada")
+		case errors.Is(err, domain.ErrWalletClosed):
+			respondError(w, http.StatusConflict, "Carteira encerrada")
+		case errors.Is(err, domain.ErrIdempotencyKey):
+			respondError(w, http.StatusConflict, "Idempotency-Key já utilizada em outra transação")
+		default:
+			log.Error().Err(err).Msg("Erro interno ao gravar lançamento")
+			respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
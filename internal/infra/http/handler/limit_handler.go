@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// LimitHandler expõe os limites de movimentação: consulta pelo cliente e configuração pelas rotas /admin
+type LimitHandler struct {
+	getWalletLimitsUC    *usecase.GetWalletLimitsUseCase
+	updateWalletLimitsUC *usecase.UpdateWalletLimitsUseCase
+	listLimitTiersUC     *usecase.ListLimitTiersUseCase
+	saveLimitTierUC      *usecase.SaveLimitTierUseCase
+}
+
+func NewLimitHandler(
+	getWalletLimitsUC *usecase.GetWalletLimitsUseCase,
+	updateWalletLimitsUC *usecase.UpdateWalletLimitsUseCase,
+	listLimitTiersUC *usecase.ListLimitTiersUseCase,
+	saveLimitTierUC *usecase.SaveLimitTierUseCase,
+) *LimitHandler {
+	return &LimitHandler{
+		getWalletLimitsUC:    getWalletLimitsUC,
+		updateWalletLimitsUC: updateWalletLimitsUC,
+		listLimitTiersUC:     listLimitTiersUC,
+		saveLimitTierUC:      saveLimitTierUC,
+	}
+}
+
+type UpdateWalletLimitsRequest struct {
+	Tier      string                       `json:"tier"`
+	Overrides usecase.VelocityLimitsOutput `json:"overrides"`
+}
+
+// GetWalletLimits mostra limites, uso e saldo restante de cada limite (GET /wallets/{id}/limits)
+func (h *LimitHandler) GetWalletLimits(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	output, err := h.getWalletLimitsUC.Execute(r.Context(), walletID)
+	if err != nil {
+		respondLimitError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// UpdateWalletLimits define a faixa e as sobrescritas da carteira (PUT /admin/wallets/{id}/limits)
+func (h *LimitHandler) UpdateWalletLimits(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	var req UpdateWalletLimitsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.updateWalletLimitsUC.Execute(r.Context(), usecase.UpdateWalletLimitsInput{
+		WalletID:  walletID,
+		Tier:      req.Tier,
+		Overrides: req.Overrides.ToDomain(),
+	})
+	if err != nil {
+		respondLimitError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// ListTiers lista as faixas de limites (GET /admin/limit-tiers)
+func (h *LimitHandler) ListTiers(w http.ResponseWriter, r *http.Request) {
+	output, err := h.listLimitTiersUC.Execute(r.Context())
+	if err != nil {
+		respondLimitError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// SaveTier cria ou substitui uma faixa (PUT /admin/limit-tiers/{code})
+func (h *LimitHandler) SaveTier(w http.ResponseWriter, r *http.Request) {
+	var req usecase.VelocityLimitsOutput
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	output, err := h.saveLimitTierUC.Execute(r.Context(), usecase.SaveLimitTierInput{
+		Code:   chi.URLParam(r, "code"),
+		Limits: req.ToDomain(),
+	})
+	if err != nil {
+		respondLimitError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code (comum às rotas de limites)
+func respondLimitError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira não encontrada")
+	case errors.Is(err, domain.ErrLimitTierNotFound):
+		respondError(w, http.StatusNotFound, "Faixa de limites não encontrada")
+	case errors.Is(err, domain.ErrInvalidLimitTier):
+		respondError(w, http.StatusBadRequest, "Código da faixa inválido (use letras minúsculas, dígitos e _)")
+	case errors.Is(err, domain.ErrInvalidLimits):
+		respondError(w, http.StatusBadRequest, "Limites devem ser zero ou positivos")
+	case errors.Is(err, domain.ErrSystemWallet):
+		respondError(w, http.StatusForbidden, "Carteiras de sistema não possuem limites de movimentação")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar limites")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// RefundHandler expõe os estornos de transferências via HTTP
+type RefundHandler struct {
+	refundTransferUC *usecase.RefundTransferUseCase
+}
+
+func NewRefundHandler(refundTransferUC *usecase.RefundTransferUseCase) *RefundHandler {
+	return &RefundHandler{
+		refundTransferUC: refundTransferUC,
+	}
+}
+
+type CreateRefundRequest struct {
+	Amount   int64  `json:"amount,omitempty"`   // Opcional: omitido estorna todo o saldo estornável
+	Currency string `json:"currency,omitempty"` // Opcional (ISO 4217): precisa ser a moeda da transação
+}
+
+// Create estorna uma transferência (POST /transactions/{id}/refunds)
+func (h *RefundHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateRefundRequest
+	// Corpo opcional: sem corpo, estorno total
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	amount, err := domain.ParseMoney(req.Amount, req.Currency)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		return
+	}
+
+	input := usecase.RefundTransferInput{
+		TransactionID: chi.URLParam(r, "id"),
+		Amount:        amount,
+	}
+	input.IdempotencyKey, input.IdempotencyScope = requestIdempotencyKey(r)
+
+	output, err := h.refundTransferUC.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrTransactionNotFound):
+			respondError(w, http.StatusNotFound, "Transação não encontrada")
+		case errors.Is(err, domain.ErrInvalidAmount):
+			respondError(w, http.StatusBadRequest, "Valor inválido")
+		case errors.Is(err, domain.ErrCurrencyMismatch):
+			respondError(w, http.StatusUnprocessableEntity, "Moeda do estorno diferente da moeda da transação")
+		case errors.Is(err, domain.ErrNotRefundable):
+			respondError(w, http.StatusConflict, "Transação não pode ser estornada")
+		case errors.Is(err, domain.ErrRefundExceedsAmount):
+			respondError(w, http.StatusUnprocessableEntity, "Valor do estorno maior que o saldo estornável")
+		case errors.Is(err, domain.ErrInsufficientFunds):
+			respondError(w, http.StatusUnprocessableEntity, "Saldo insuficiente na carteira de destino para o estorno")
+		case errors.Is(err, domain.ErrSystemWallet):
+			respondError(w, http.StatusForbidden, "Carteiras de sistema não podem ser movimentadas")
+		case errors.Is(err, domain.ErrWalletFrozen):
+			respondError(w, http.StatusLocked, "Carteira congelada")
+		case errors.Is(err, domain.ErrWalletClosed):
+			respondError(w, http.StatusConflict, "Carteira encerrada")
+		default:
+			log.Error().Err(err).Msg("Erro interno ao processar estorno")
+			respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
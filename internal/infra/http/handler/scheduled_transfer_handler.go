@@ -0,0 +1,153 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// ScheduledTransferHandler expõe as transferências agendadas via HTTP
+type ScheduledTransferHandler struct {
+	createScheduleUC *usecase.CreateScheduledTransferUseCase
+	getScheduleUC    *usecase.GetScheduledTransferUseCase
+	listSchedulesUC  *usecase.ListScheduledTransfersUseCase
+	cancelScheduleUC *usecase.CancelScheduledTransferUseCase
+}
+
+func NewScheduledTransferHandler(
+	createScheduleUC *usecase.CreateScheduledTransferUseCase,
+	getScheduleUC *usecase.GetScheduledTransferUseCase,
+	listSchedulesUC *usecase.ListScheduledTransfersUseCase,
+	cancelScheduleUC *usecase.CancelScheduledTransferUseCase,
+) *ScheduledTransferHandler {
+	return &ScheduledTransferHandler{
+		createScheduleUC: createScheduleUC,
+		getScheduleUC:    getScheduleUC,
+		listSchedulesUC:  listSchedulesUC,
+		cancelScheduleUC: cancelScheduleUC,
+	}
+}
+
+type CreateScheduledTransferRequest struct {
+	FromWalletID int64  `json:"from_wallet_id"`
+	ToWalletID   int64  `json:"to_wallet_id"`
+	Amount       int64  `json:"amount"`             // Na menor unidade da moeda
+	Currency     string `json:"currency,omitempty"` // Opcional (ISO 4217)
+	ExecuteAt    string `json:"execute_at"`         // RFC3339
+}
+
+// Create agenda uma transferência (POST /scheduled-transfers)
+func (h *ScheduledTransferHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateScheduledTransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	executeAt, err := time.Parse(time.RFC3339, req.ExecuteAt)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Parâmetro 'execute_at' inválido (use RFC3339)")
+		return
+	}
+
+	amount, err := domain.ParseMoney(req.Amount, req.Currency)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		return
+	}
+
+	input := usecase.CreateScheduledTransferInput{
+		FromWalletID: req.FromWalletID,
+		ToWalletID:   req.ToWalletID,
+		Amount:       amount,
+		ExecuteAt:    executeAt,
+	}
+
+	output, err := h.createScheduleUC.Execute(r.Context(), input)
+	if err != nil {
+		respondScheduleError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
+
+// List lista os agendamentos de uma carteira (GET /scheduled-transfers?wallet_id=1&status=pending)
+func (h *ScheduledTransferHandler) List(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	walletID, err := strconv.ParseInt(query.Get("wallet_id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Parâmetro 'wallet_id' é obrigatório")
+		return
+	}
+
+	output, err := h.listSchedulesUC.Execute(r.Context(), usecase.ListScheduledTransfersInput{
+		WalletID: walletID,
+		Status:   query.Get("status"),
+	})
+	if err != nil {
+		respondScheduleError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Get consulta um agendamento (GET /scheduled-transfers/{id})
+func (h *ScheduledTransferHandler) Get(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getScheduleUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		respondScheduleError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Cancel cancela um agendamento pendente (POST /scheduled-transfers/{id}/cancel)
+func (h *ScheduledTransferHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	output, err := h.cancelScheduleUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		respondScheduleError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code (comum a todas as rotas de agendamento)
+func respondScheduleError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrScheduleNotFound):
+		respondError(w, http.StatusNotFound, "Agendamento não encontrado")
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira não encontrada")
+	case errors.Is(err, domain.ErrInvalidAmount):
+		respondError(w, http.StatusBadRequest, "Valor inválido")
+	case errors.Is(err, domain.ErrInvalidSchedule):
+		respondError(w, http.StatusBadRequest, "Data de execução deve estar no futuro")
+	case errors.Is(err, domain.ErrSameWallet):
+		respondError(w, http.StatusBadRequest, "Carteiras de origem e destino devem ser diferentes")
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		respondError(w, http.StatusUnprocessableEntity, "Moedas das carteiras não conferem")
+	case errors.Is(err, domain.ErrSystemWallet):
+		respondError(w, http.StatusForbidden, "Carteiras de sistema não podem ser movimentadas")
+	case errors.Is(err, domain.ErrWalletFrozen):
+		respondError(w, http.StatusLocked, "Carteira congelada")
+	case errors.Is(err, domain.ErrWalletClosed):
+		respondError(w, http.StatusConflict, "Carteira encerrada")
+	case errors.Is(err, domain.ErrScheduleNotPending):
+		respondError(w, http.StatusConflict, "Agendamento não está mais pendente")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar agendamento")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
@@ -0,0 +1,222 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// StandingOrderHandler expõe as ordens permanentes (transferências recorrentes) via HTTP
+type StandingOrderHandler struct {
+	createOrderUC *usecase.CreateStandingOrderUseCase
+	getOrderUC    *usecase.GetStandingOrderUseCase
+	listOrdersUC  *usecase.ListStandingOrdersUseCase
+	updateOrderUC *usecase.UpdateStandingOrderUseCase
+	cancelOrderUC *usecase.CancelStandingOrderUseCase
+}
+
+func NewStandingOrderHandler(
+	createOrderUC *usecase.CreateStandingOrderUseCase,
+	getOrderUC *usecase.GetStandingOrderUseCase,
+	listOrdersUC *usecase.ListStandingOrdersUseCase,
+	updateOrderUC *usecase.UpdateStandingOrderUseCase,
+	cancelOrderUC *usecase.CancelStandingOrderUseCase,
+) *StandingOrderHandler {
+	return &StandingOrderHandler{
+		createOrderUC: createOrderUC,
+		getOrderUC:    getOrderUC,
+		listOrdersUC:  listOrdersUC,
+		updateOrderUC: updateOrderUC,
+		cancelOrderUC: cancelOrderUC,
+	}
+}
+
+// CreateStandingOrderRequest aceita "rrule" ou os campos estruturados (frequency, day_of_month...), nunca os dois
+type CreateStandingOrderRequest struct {
+	FromWalletID int64  `json:"from_wallet_id"`
+	ToWalletID   int64  `json:"to_wallet_id"`
+	Amount       int64  `json:"amount"`             // Na menor unidade da moeda
+	Currency     string `json:"currency,omitempty"` // Opcional (ISO 4217)
+	StartAt      string `json:"start_at,omitempty"` // RFC3339. Opcional (padrão: agora)
+
+	RRule      string   `json:"rrule,omitempty"`        // Ex: "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12"
+	Frequency  string   `json:"frequency,omitempty"`    // daily, weekly ou monthly
+	Interval   int      `json:"interval,omitempty"`     // A cada N períodos
+	DayOfMonth int      `json:"day_of_month,omitempty"` // monthly: 1..31 ou -1 (último dia)
+	Weekdays   []string `json:"weekdays,omitempty"`     // weekly: ["MO", "TH"]
+	Count      int      `json:"count,omitempty"`        // Total de ocorrências
+	Until      string   `json:"until,omitempty"`        // RFC3339
+
+	InsufficientFundsPolicy string `json:"insufficient_funds_policy,omitempty"` // skip (padrão) ou retry
+	MaxRetries              *int32 `json:"max_retries,omitempty"`
+	RetryIntervalSeconds    int64  `json:"retry_interval_seconds,omitempty"`
+}
+
+// Create cadastra uma ordem permanente (POST /standing-orders)
+func (h *StandingOrderHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateStandingOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	input := usecase.CreateStandingOrderInput{
+		FromWalletID:            req.FromWalletID,
+		ToWalletID:              req.ToWalletID,
+		RRule:                   req.RRule,
+		Frequency:               req.Frequency,
+		Interval:                req.Interval,
+		DayOfMonth:              req.DayOfMonth,
+		Weekdays:                req.Weekdays,
+		Count:                   req.Count,
+		InsufficientFundsPolicy: req.InsufficientFundsPolicy,
+		MaxRetries:              req.MaxRetries,
+		RetryInterval:           time.Duration(req.RetryIntervalSeconds) * time.Second,
+	}
+
+	var err error
+	if req.StartAt != "" {
+		if input.StartAt, err = time.Parse(time.RFC3339, req.StartAt); err != nil {
+			respondError(w, http.StatusBadRequest, "Parâmetro 'start_at' inválido (use RFC3339)")
+			return
+		}
+	}
+	if req.Until != "" {
+		until, err := time.Parse(time.RFC3339, req.Until)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "Parâmetro 'until' inválido (use RFC3339)")
+			return
+		}
+		input.Until = &until
+	}
+	if input.Amount, err = domain.ParseMoney(req.Amount, req.Currency); err != nil {
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		return
+	}
+
+	output, err := h.createOrderUC.Execute(r.Context(), input)
+	if err != nil {
+		respondStandingOrderError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusCreated, output)
+}
+
+// List lista as ordens de uma carteira de origem (GET /standing-orders?wallet_id=1)
+func (h *StandingOrderHandler) List(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(r.URL.Query().Get("wallet_id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Parâmetro 'wallet_id' é obrigatório")
+		return
+	}
+
+	output, err := h.listOrdersUC.Execute(r.Context(), walletID)
+	if err != nil {
+		respondStandingOrderError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Get consulta uma ordem e as ocorrências recentes (GET /standing-orders/{id})
+func (h *StandingOrderHandler) Get(w http.ResponseWriter, r *http.Request) {
+	output, err := h.getOrderUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		respondStandingOrderError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// UpdateStandingOrderRequest: campos ausentes não são alterados
+type UpdateStandingOrderRequest struct {
+	Amount                  *int64  `json:"amount,omitempty"`
+	InsufficientFundsPolicy *string `json:"insufficient_funds_policy,omitempty"`
+	MaxRetries              *int32  `json:"max_retries,omitempty"`
+	RetryIntervalSeconds    *int64  `json:"retry_interval_seconds,omitempty"`
+	Status                  *string `json:"status,omitempty"` // active (retoma) ou paused
+}
+
+// Update altera valor, política ou pausa/retoma a ordem (PATCH /standing-orders/{id})
+func (h *StandingOrderHandler) Update(w http.ResponseWriter, r *http.Request) {
+	var req UpdateStandingOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	input := usecase.UpdateStandingOrderInput{
+		OrderID:                 chi.URLParam(r, "id"),
+		Amount:                  req.Amount,
+		InsufficientFundsPolicy: req.InsufficientFundsPolicy,
+		MaxRetries:              req.MaxRetries,
+		Status:                  req.Status,
+	}
+	if req.RetryIntervalSeconds != nil {
+		retryInterval := time.Duration(*req.RetryIntervalSeconds) * time.Second
+		input.RetryInterval = &retryInterval
+	}
+
+	output, err := h.updateOrderUC.Execute(r.Context(), input)
+	if err != nil {
+		respondStandingOrderError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Cancel cancela a ordem (DELETE /standing-orders/{id}). O histórico é mantido.
+func (h *StandingOrderHandler) Cancel(w http.ResponseWriter, r *http.Request) {
+	output, err := h.cancelOrderUC.Execute(r.Context(), chi.URLParam(r, "id"))
+	if err != nil {
+		respondStandingOrderError(w, err)
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Mapeamento de Erros de Domínio -> HTTP Status Code (comum a todas as rotas de ordens permanentes)
+func respondStandingOrderError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrOrderNotFound):
+		respondError(w, http.StatusNotFound, "Ordem permanente não encontrada")
+	case errors.Is(err, domain.ErrWalletNotFound):
+		respondError(w, http.StatusNotFound, "Carteira não encontrada")
+	case errors.Is(err, domain.ErrInvalidAmount):
+		respondError(w, http.StatusBadRequest, "Valor inválido")
+	case errors.Is(err, domain.ErrInvalidRecurrence):
+		// A mensagem aponta o parâmetro problemático da regra
+		respondError(w, http.StatusBadRequest, "Recorrência inválida: "+err.Error())
+	case errors.Is(err, domain.ErrInvalidOrderPolicy):
+		respondError(w, http.StatusBadRequest, "Política de saldo insuficiente inválida (use skip ou retry, com max_retries >= 0 e retry_interval_seconds >= 1)")
+	case errors.Is(err, domain.ErrInvalidOrderStatus):
+		respondError(w, http.StatusBadRequest, "Status deve ser 'active' ou 'paused' (para cancelar, use DELETE)")
+	case errors.Is(err, domain.ErrSameWallet):
+		respondError(w, http.StatusBadRequest, "Carteiras de origem e destino devem ser diferentes")
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		respondError(w, http.StatusUnprocessableEntity, "Moedas das carteiras não conferem")
+	case errors.Is(err, domain.ErrSystemWallet):
+		respondError(w, http.StatusForbidden, "Carteiras de sistema não podem ser movimentadas")
+	case errors.Is(err, domain.ErrWalletFrozen):
+		respondError(w, http.StatusLocked, "Carteira congelada")
+	case errors.Is(err, domain.ErrWalletClosed):
+		respondError(w, http.StatusConflict, "Carteira encerrada")
+	case errors.Is(err, domain.ErrOrderNotActive):
+		respondError(w, http.StatusConflict, "Ordem permanente já foi encerrada")
+	default:
+		log.Error().Err(err).Msg("Erro interno ao processar ordem permanente")
+		respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+	}
+}
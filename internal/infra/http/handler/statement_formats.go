@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+)
+
+// writeStatementCSV escreve o extrato em CSV (separador vírgula, valores em unidades da moeda com ponto).
+// A primeira e a última linha trazem os saldos de abertura e de fechamento.
+func writeStatementCSV(w io.Writer, statement *usecase.StatementOutput) error {
+	currency := domain.Currency(statement.Currency)
+	amount := func(v int64) string {
+		return domain.Money{Amount: v, Currency: currency}.FormatAmount()
+	}
+
+	writer := csv.NewWriter(w)
+	records := [][]string{
+		{"data", "tipo", "descricao", "memo", "transaction_id", "carteira_contraparte", "valor", "tarifa", "saldo", "moeda"},
+		{statement.From, "saldo_inicial", "Saldo inicial", "", "", "", "", "", amount(statement.OpeningBalance), statement.Currency},
+	}
+	for _, line := range statement.Lines {
+		counterparty, fee := "", ""
+		if line.CounterpartyWalletID != 0 {
+			counterparty = strconv.FormatInt(line.CounterpartyWalletID, 10)
+		}
+		if line.FeeAmount != 0 {
+			fee = amount(line.FeeAmount)
+		}
+		records = append(records, []string{
+			line.PostedAt,
+			line.Kind,
+			line.Description,
+			line.Memo,
+			line.TransactionID,
+			counterparty,
+			amount(line.Amount),
+			fee,
+			amount(line.Balance),
+			statement.Currency,
+		})
+	}
+	records = append(records, []string{statement.To, "saldo_final", "Saldo final", "", "", "", "", "", amount(statement.ClosingBalance), statement.Currency})
+
+	return writer.WriteAll(records) // WriteAll já faz o Flush
+}
+
+// ofxBankID identifica a instituição no OFX (BANKID aceita até 9 caracteres)
+const ofxBankID = "LEDGER"
+
+// Estrutura mínima de um extrato bancário OFX 2.2 (STMTRS)
+type ofxDocument struct {
+	XMLName xml.Name `xml:"OFX"`
+	Signon  struct {
+		Response struct {
+			Status   ofxStatus `xml:"STATUS"`
+			DTServer string    `xml:"DTSERVER"`
+			Language string    `xml:"LANGUAGE"`
+		} `xml:"SONRS"`
+	} `xml:"SIGNONMSGSRSV1"`
+	Bank struct {
+		Transaction struct {
+			TrnUID    string       `xml:"TRNUID"`
+			Status    ofxStatus    `xml:"STATUS"`
+			Statement ofxStatement `xml:"STMTRS"`
+		} `xml:"STMTTRNRS"`
+	} `xml:"BANKMSGSRSV1"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxStatement struct {
+	Currency string `xml:"CURDEF"`
+	Account  struct {
+		BankID      string `xml:"BANKID"`
+		AccountID   string `xml:"ACCTID"`
+		AccountType string `xml:"ACCTTYPE"`
+	} `xml:"BANKACCTFROM"`
+	TransactionList struct {
+		Start        string              `xml:"DTSTART"`
+		End          string              `xml:"DTEND"`
+		Transactions []ofxTransactionRow `xml:"STMTTRN"`
+	} `xml:"BANKTRANLIST"`
+	LedgerBalance struct {
+		Amount string `xml:"BALAMT"`
+		AsOf   string `xml:"DTASOF"`
+	} `xml:"LEDGERBAL"`
+}
+
+type ofxTransactionRow struct {
+	Type   string `xml:"TRNTYPE"`
+	Posted string `xml:"DTPOSTED"`
+	Amount string `xml:"TRNAMT"`
+	FITID  string `xml:"FITID"` // Identificador único e estável: o ID da partida (evita importação duplicada)
+	Name   string `xml:"NAME,omitempty"`
+	Memo   string `xml:"MEMO,omitempty"`
+}
+
+// writeStatementOFX escreve o extrato em OFX 2.2. A carteira vira uma conta corrente (CHECKING)
+// com ACCTID = ID da carteira; o saldo de fechamento vai em LEDGERBAL.
+func writeStatementOFX(w io.Writer, statement *usecase.StatementOutput) error {
+	currency := domain.Currency(statement.Currency)
+	amount := func(v int64) string {
+		return domain.Money{Amount: v, Currency: currency}.FormatAmount()
+	}
+
+	var doc ofxDocument
+	doc.Signon.Response.Status = ofxStatus{Code: 0, Severity: "INFO"}
+	doc.Signon.Response.DTServer = ofxTime(time.Now())
+	doc.Signon.Response.Language = "POR"
+
+	doc.Bank.Transaction.TrnUID = "0"
+	doc.Bank.Transaction.Status = ofxStatus{Code: 0, Severity: "INFO"}
+
+	stmt := &doc.Bank.Transaction.Statement
+	stmt.Currency = statement.Currency
+	stmt.Account.BankID = ofxBankID
+	stmt.Account.AccountID = strconv.FormatInt(statement.WalletID, 10)
+	stmt.Account.AccountType = "CHECKING"
+	stmt.TransactionList.Start = ofxTime(statement.PeriodStart)
+	stmt.TransactionList.End = ofxTime(statement.PeriodEnd)
+	stmt.TransactionList.Transactions = make([]ofxTransactionRow, 0, len(statement.Lines))
+	for _, line := range statement.Lines {
+		posted, err := time.Parse(time.RFC3339, line.PostedAt)
+		if err != nil {
+			return err
+		}
+		stmt.TransactionList.Transactions = append(stmt.TransactionList.Transactions, ofxTransactionRow{
+			Type:   ofxTransactionType(line),
+			Posted: ofxTime(posted),
+			Amount: amount(line.Amount),
+			FITID:  strconv.FormatInt(line.EntryID, 10),
+			Name:   line.Description,
+			Memo:   line.Memo,
+		})
+	}
+	stmt.LedgerBalance.Amount = amount(statement.ClosingBalance)
+	stmt.LedgerBalance.AsOf = ofxTime(statement.PeriodEnd)
+
+	header := `<?xml version="1.0" encoding="UTF-8" standalone="no"?>` + "\n" + `<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>` + "\n"
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ofxTransactionType traduz o tipo da partida para o TRNTYPE do OFX
+func ofxTransactionType(line usecase.StatementLineOutput) string {
+	switch line.Kind {
+	case domain.TransactionKindDeposit:
+		return "DEP"
+	case domain.TransactionKindWithdrawal:
+		return "CASH"
+	case domain.TransactionKindTransfer:
+		return "XFER"
+	case usecase.StatementKindOpening:
+		return "OTHER"
+	}
+	if line.Amount < 0 {
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+// ofxTime formata no padrão de data do OFX, em UTC (ex: 20260303170000.000[0:GMT])
+func ofxTime(t time.Time) string {
+	return t.UTC().Format("20060102150405.000") + "[0:GMT]"
+}
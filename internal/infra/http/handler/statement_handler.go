@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// Formatos do extrato
+const (
+	StatementFormatJSON = "json"
+	StatementFormatCSV  = "csv"
+	StatementFormatOFX  = "ofx" // OFX 2.2 (XML), importado por programas de finanças pessoais
+)
+
+// StatementHandler expõe o extrato do período para download
+type StatementHandler struct {
+	generateStatementUC *usecase.GenerateStatementUseCase
+}
+
+func NewStatementHandler(generateStatementUC *usecase.GenerateStatementUseCase) *StatementHandler {
+	return &StatementHandler{
+		generateStatementUC: generateStatementUC,
+	}
+}
+
+// Get gera o extrato (GET /wallets/{id}/statements?from=2026-03-01&to=2026-03-31&format=ofx).
+// Padrão: o mês passado, em JSON.
+func (h *StatementHandler) Get(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	query := r.URL.Query()
+	format := query.Get("format")
+	if format == "" {
+		format = StatementFormatJSON
+	}
+	if format != StatementFormatJSON && format != StatementFormatCSV && format != StatementFormatOFX {
+		respondError(w, http.StatusBadRequest, "format deve ser 'json', 'csv' ou 'ofx'")
+		return
+	}
+
+	input := usecase.GenerateStatementInput{WalletID: walletID}
+	if v := query.Get("from"); v != "" {
+		from, err := time.ParseInLocation("2006-01-02", v, domain.BusinessLocation)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "from inválido (use AAAA-MM-DD)")
+			return
+		}
+		input.From = &from
+	}
+	if v := query.Get("to"); v != "" {
+		to, err := time.ParseInLocation("2006-01-02", v, domain.BusinessLocation)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, "to inválido (use AAAA-MM-DD)")
+			return
+		}
+		input.To = &to
+	}
+
+	statement, err := h.generateStatementUC.Execute(r.Context(), input)
+	if err != nil {
+		respondBalanceError(w, err)
+		return
+	}
+
+	if format == StatementFormatJSON {
+		respondJSON(w, http.StatusOK, statement)
+		return
+	}
+
+	filename := fmt.Sprintf("extrato-carteira-%d-%s-a-%s.%s", statement.WalletID, statement.From, statement.To, format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	switch format {
+	case StatementFormatCSV:
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		err = writeStatementCSV(w, statement)
+	case StatementFormatOFX:
+		w.Header().Set("Content-Type", "application/x-ofx")
+		w.WriteHeader(http.StatusOK)
+		err = writeStatementOFX(w, statement)
+	}
+	if err != nil {
+		// O status já foi enviado: só resta registrar
+		log.Error().Err(err).Int64("wallet_id", walletID).Str("format", format).Msg("Falha ao escrever extrato")
+	}
+}
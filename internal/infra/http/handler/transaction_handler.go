@@ -0,0 +1,158 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog/log"
+)
+
+// TransactionHandler expõe a consulta de transações via HTTP
+type TransactionHandler struct {
+	listTransactionsUC *usecase.ListTransactionsUseCase
+	getTransactionUC   *usecase.GetTransactionUseCase
+}
+
+func NewTransactionHandler(
+	listTransactionsUC *usecase.ListTransactionsUseCase,
+	getTransactionUC *usecase.GetTransactionUseCase,
+) *TransactionHandler {
+	return &TransactionHandler{
+		listTransactionsUC: listTransactionsUC,
+		getTransactionUC:   getTransactionUC,
+	}
+}
+
+// Get busca uma transação pelo ID (GET /transactions/{id})
+func (h *TransactionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	h.get(w, r, usecase.GetTransactionInput{ID: chi.URLParam(r, "id")})
+}
+
+// GetByIdempotencyKey busca uma transação pela chave que o próprio cliente usou
+// (GET /transactions?idempotency_key=...&route=POST /transfers). route é opcional (padrão "POST /transfers").
+// A chave só é procurada no escopo do cliente da requisição: ninguém lê a transação de outro cliente.
+func (h *TransactionHandler) GetByIdempotencyKey(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	key := gateway.IdempotencyKey{Key: query.Get("idempotency_key"), Route: query.Get("route")}
+	if key.Key == "" {
+		respondError(w, http.StatusBadRequest, "idempotency_key é obrigatório")
+		return
+	}
+	if key.Route == "" {
+		key.Route = http.MethodPost + " /transfers"
+	}
+	client, ok := gateway.IdempotencyKeyFromContext(r.Context())
+	if !ok {
+		// Sem o middleware de idempotência não há como saber o escopo do cliente
+		respondError(w, http.StatusNotFound, "Transação não encontrada")
+		return
+	}
+	key.ClientID = client.ClientID
+	h.get(w, r, usecase.GetTransactionInput{IdempotencyKey: key.Key, IdempotencyScope: key.Scope()})
+}
+
+func (h *TransactionHandler) get(w http.ResponseWriter, r *http.Request, input usecase.GetTransactionInput) {
+	output, err := h.getTransactionUC.Execute(r.Context(), input)
+	if err != nil {
+		if errors.Is(err, domain.ErrTransactionNotFound) {
+			respondError(w, http.StatusNotFound, "Transação não encontrada")
+			return
+		}
+		log.Error().Err(err).Msg("Erro ao buscar transação")
+		respondError(w, http.StatusInternalServerError, "Erro interno")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// ListByWallet retorna o extrato da carteira (GET /wallets/{id}/transactions)
+// Filtros: cursor, limit, direction (in|out), from/to (RFC3339), min_amount/max_amount, status
+func (h *TransactionHandler) ListByWallet(w http.ResponseWriter, r *http.Request) {
+	walletID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	query := r.URL.Query()
+	input := usecase.ListTransactionsInput{
+		WalletID:  walletID,
+		Cursor:    query.Get("cursor"),
+		Direction: query.Get("direction"),
+		Status:    query.Get("status"),
+	}
+
+	if input.Direction != "" && input.Direction != domain.DirectionIn && input.Direction != domain.DirectionOut {
+		respondError(w, http.StatusBadRequest, "direction deve ser 'in' ou 'out'")
+		return
+	}
+
+	if v := query.Get("limit"); v != "" {
+		if input.Limit, err = strconv.Atoi(v); err != nil {
+			respondError(w, http.StatusBadRequest, "limit inválido")
+			return
+		}
+	}
+	if input.From, err = parseTimeParam(query.Get("from")); err != nil {
+		respondError(w, http.StatusBadRequest, "from inválido (use RFC3339)")
+		return
+	}
+	if input.To, err = parseTimeParam(query.Get("to")); err != nil {
+		respondError(w, http.StatusBadRequest, "to inválido (use RFC3339)")
+		return
+	}
+	if input.MinAmount, err = parseInt64Param(query.Get("min_amount")); err != nil {
+		respondError(w, http.StatusBadRequest, "min_amount inválido")
+		return
+	}
+	if input.MaxAmount, err = parseInt64Param(query.Get("max_amount")); err != nil {
+		respondError(w, http.StatusBadRequest, "max_amount inválido")
+		return
+	}
+
+	output, err := h.listTransactionsUC.Execute(r.Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrWalletNotFound):
+			respondError(w, http.StatusNotFound, "Carteira não encontrada")
+		case errors.Is(err, domain.ErrInvalidCursor):
+			respondError(w, http.StatusBadRequest, "Cursor inválido")
+		default:
+			log.Error().Err(err).Msg("Erro ao listar transações")
+			respondError(w, http.StatusInternalServerError, "Erro interno")
+		}
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
+
+// Helpers para query params opcionais (vazio = nil)
+func parseTimeParam(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func parseInt64Param(v string) (*int64, error) {
+	if v == "" {
+		return nil, nil
+	}
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
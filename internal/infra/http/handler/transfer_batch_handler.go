@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/usecase"
+)
+
+// TransferBatchHandler expõe os lotes de transferências (ex: folha de pagamento) via HTTP
+type TransferBatchHandler struct {
+	transferBatchUC *usecase.TransferBatchUseCase
+}
+
+func NewTransferBatchHandler(transferBatchUC *usecase.TransferBatchUseCase) *TransferBatchHandler {
+	return &TransferBatchHandler{
+		transferBatchUC: transferBatchUC,
+	}
+}
+
+type TransferBatchLegRequest struct {
+	FromWalletID int64  `json:"from_wallet_id"`
+	ToWalletID   int64  `json:"to_wallet_id"`
+	Amount       int64  `json:"amount"`             // Na menor unidade da moeda
+	Currency     string `json:"currency,omitempty"` // Opcional (ISO 4217)
+}
+
+type CreateTransferBatchRequest struct {
+	Mode string                    `json:"mode"` // all_or_nothing ou best_effort
+	Legs []TransferBatchLegRequest `json:"legs"`
+}
+
+type TransferBatchLegResponse struct {
+	Index         int    `json:"index"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+}
+
+type CreateTransferBatchResponse struct {
+	Mode      string                     `json:"mode"`
+	Completed int                        `json:"completed"`
+	Failed    int                        `json:"failed"`
+	Legs      []TransferBatchLegResponse `json:"legs"`
+}
+
+// Create executa um lote de transferências (POST /transfer-batches).
+// all_or_nothing: 201 se todas as pernas passaram, senão o erro da perna que abortou o lote.
+// best_effort: 201 se todas passaram, 207 com o resultado de cada perna se alguma falhou.
+func (h *TransferBatchHandler) Create(w http.ResponseWriter, r *http.Request) {
+	var req CreateTransferBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		respondError(w, http.StatusBadRequest, "Payload inválido")
+		return
+	}
+
+	input := usecase.TransferBatchInput{
+		Mode: req.Mode,
+		Legs: make([]usecase.TransferBatchLeg, 0, len(req.Legs)),
+	}
+	for i, leg := range req.Legs {
+		amount, err := domain.ParseMoney(leg.Amount, leg.Currency)
+		if err != nil {
+			respondError(w, http.StatusBadRequest, fmt.Sprintf("Perna %d: Moeda não suportada", i))
+			return
+		}
+		input.Legs = append(input.Legs, usecase.TransferBatchLeg{
+			FromWalletID: leg.FromWalletID,
+			ToWalletID:   leg.ToWalletID,
+			Amount:       amount,
+		})
+	}
+	input.IdempotencyKey, input.IdempotencyScope = requestIdempotencyKey(r)
+
+	output, err := h.transferBatchUC.Execute(r.Context(), input)
+	if err != nil {
+		var legErr *usecase.TransferBatchLegError
+		switch {
+		case errors.Is(err, domain.ErrInvalidBatch):
+			respondError(w, http.StatusBadRequest, fmt.Sprintf("O lote deve ter entre 1 e %d pernas", usecase.MaxTransferBatchLegs))
+		case errors.Is(err, domain.ErrInvalidBatchMode):
+			respondError(w, http.StatusBadRequest, "Modo deve ser 'all_or_nothing' ou 'best_effort'")
+		case errors.As(err, &legErr):
+			status, message := transferErrorResponse(legErr.Err)
+			respondError(w, status, fmt.Sprintf("Perna %d: %s (nenhuma transferência do lote foi executada)", legErr.Index, message))
+		default:
+			status, message := transferErrorResponse(err)
+			respondError(w, status, message)
+		}
+		return
+	}
+
+	response := CreateTransferBatchResponse{
+		Mode:      output.Mode,
+		Completed: output.Completed,
+		Failed:    output.Failed,
+		Legs:      make([]TransferBatchLegResponse, 0, len(output.Legs)),
+	}
+	for _, leg := range output.Legs {
+		legResponse := TransferBatchLegResponse{
+			Index:         leg.Index,
+			TransactionID: leg.TransactionID,
+			Status:        leg.Status,
+		}
+		if leg.Err != nil {
+			_, legResponse.Error = transferErrorResponse(leg.Err)
+		}
+		response.Legs = append(response.Legs, legResponse)
+	}
+
+	status := http.StatusCreated
+	if output.Failed > 0 {
+		status = http.StatusMultiStatus
+	}
+	respondJSON(w, status, response)
+}
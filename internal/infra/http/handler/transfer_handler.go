@@ -26,14 +26,17 @@ func NewTransferHandler(uc *usecase.TransferMoneyUseCase) *TransferHandler {
 // DTOs (Data Transfer Objects) para Request/Response
 // Usamos tags JSON para mapear snake_case (padrão de APIs)
 type CreateTransferRequest struct {
-	FromWalletID int64 `json:"from_wallet_id"`
-	ToWalletID   int64 `json:"to_wallet_id"`
-	Amount       int64 `json:"amount"` // Valor em centavos
+	FromWalletID int64  `json:"from_wallet_id"`
+	ToWalletID   int64  `json:"to_wallet_id"`
+	Amount       int64  `json:"amount"`             // Na menor unidade da moeda (centavos para BRL)
+	Currency     string `json:"currency,omitempty"` // Opcional (ISO 4217)
+	QuoteID      string `json:"quote_id,omitempty"` // Cotação travada em POST /fx/quotes (entre moedas diferentes)
 }
 
 type CreateTransferResponse struct {
 	TransactionID string `json:"transaction_id"`
 	Status        string `json:"status"`
+	FeeAmount     int64  `json:"fee_amount"` // Debitada do remetente além de amount
 }
 
 // Create processa a requisição de transferência
@@ -46,45 +49,99 @@ func (h *TransferHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	idempotencyKey := r.Header.Get("Idempotency-Key")
-	fmt.Println(idempotencyKey)
-	var idempotencyKeyPtr *string
-	if idempotencyKey != "" {
-		idempotencyKeyPtr = &idempotencyKey
+	amount, err := domain.ParseMoney(req.Amount, req.Currency)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "Moeda não suportada")
+		return
 	}
-	fmt.Println(idempotencyKeyPtr)
 
 	input := usecase.TransferMoneyInput{
-		FromWalletID:   req.FromWalletID,
-		ToWalletID:     req.ToWalletID,
-		Amount:         req.Amount,
-		IdempotencyKey: idempotencyKeyPtr,
+		FromWalletID: req.FromWalletID,
+		ToWalletID:   req.ToWalletID,
+		Amount:       amount,
+		QuoteID:      req.QuoteID,
 	}
+	input.IdempotencyKey, input.IdempotencyScope = requestIdempotencyKey(r)
 
 	output, err := h.transferUseCase.Execute(ctx, input)
 	if err != nil {
-		// Mapeamento de Erros de Domínio -> HTTP Status Code
-		switch {
-		case errors.Is(err, domain.ErrWalletNotFound):
-			respondError(w, http.StatusNotFound, "Carteira não encontrada")
-		case errors.Is(err, domain.ErrInsufficientFunds):
-			respondError(w, http.StatusUnprocessableEntity, "Saldo insuficiente")
-		case errors.Is(err, domain.ErrInvalidAmount):
-			respondError(w, http.StatusBadRequest, "Valor inválido")
-		default:
-			// Erro interno (banco caiu, bug, etc)
-			log.Error().Err(err).Msg("Erro interno ao processar transferência")
-			respondError(w, http.StatusInternalServerError, "Erro interno do servidor")
+		// Limite excedido: o cliente recebe também qual limite e quanto ainda resta dele
+		var limitErr *domain.LimitExceededError
+		if errors.As(err, &limitErr) {
+			respondLimitExceeded(w, limitErr)
+			return
 		}
+		status, message := transferErrorResponse(err)
+		respondError(w, status, message)
 		return
 	}
 
 	respondJSON(w, http.StatusCreated, CreateTransferResponse{
 		TransactionID: output.TransactionID,
 		Status:        output.Status,
+		FeeAmount:     output.FeeAmount,
+	})
+}
+
+// transferErrorResponse faz o Mapeamento de Erros de Domínio -> HTTP Status Code das transferências.
+// Compartilhado com os lotes, que reportam o erro de cada perna.
+func transferErrorResponse(err error) (int, string) {
+	var limitErr *domain.LimitExceededError
+	switch {
+	case errors.Is(err, domain.ErrWalletNotFound):
+		return http.StatusNotFound, "Carteira não encontrada"
+	case errors.Is(err, domain.ErrInsufficientFunds):
+		return http.StatusUnprocessableEntity, "Saldo insuficiente"
+	case errors.Is(err, domain.ErrInvalidAmount):
+		return http.StatusBadRequest, "Valor inválido"
+	case errors.Is(err, domain.ErrCurrencyMismatch):
+		return http.StatusUnprocessableEntity, "Moedas das carteiras não conferem (use uma cotação de câmbio)"
+	case errors.Is(err, domain.ErrSystemWallet):
+		return http.StatusForbidden, "Carteiras de sistema não podem ser movimentadas"
+	case errors.Is(err, domain.ErrWalletFrozen):
+		return http.StatusLocked, "Carteira congelada"
+	case errors.Is(err, domain.ErrWalletClosed):
+		return http.StatusConflict, "Carteira encerrada"
+	case errors.As(err, &limitErr):
+		return http.StatusUnprocessableEntity, limitExceededMessage(limitErr)
+	case errors.Is(err, domain.ErrQuoteNotFound):
+		return http.StatusNotFound, "Cotação não encontrada"
+	case errors.Is(err, domain.ErrQuoteExpired):
+		return http.StatusGone, "Cotação expirada"
+	case errors.Is(err, domain.ErrQuoteAlreadyUsed):
+		return http.StatusConflict, "Cotação já utilizada"
+	case errors.Is(err, domain.ErrIdempotencyKey):
+		return http.StatusConflict, "Idempotency-Key já utilizada em outra transação"
+	case errors.Is(err, domain.ErrQuoteMismatch):
+		return http.StatusUnprocessableEntity, "Transferência não confere com a cotação"
+	default:
+		// Erro interno (banco caiu, bug, etc)
+		log.Error().Err(err).Msg("Erro interno ao processar transferência")
+		return http.StatusInternalServerError, "Erro interno do servidor"
+	}
+}
+
+// LimitExceededResponse é o corpo do 422 de limite de movimentação excedido
+type LimitExceededResponse struct {
+	Error     string `json:"error"`
+	Limit     string `json:"limit"`
+	Max       int64  `json:"max"`
+	Remaining int64  `json:"remaining"`
+}
+
+func respondLimitExceeded(w http.ResponseWriter, limitErr *domain.LimitExceededError) {
+	respondJSON(w, http.StatusUnprocessableEntity, LimitExceededResponse{
+		Error:     limitExceededMessage(limitErr),
+		Limit:     limitErr.Limit,
+		Max:       limitErr.Max,
+		Remaining: limitErr.Remaining,
 	})
 }
 
+func limitExceededMessage(limitErr *domain.LimitExceededError) string {
+	return fmt.Sprintf("Limite de movimentação excedido (%s): disponível %d", limitErr.Limit, limitErr.Remaining)
+}
+
 // Helpers para resposta JSON
 func respondJSON(w http.ResponseWriter, status int, payload interface{}) {
 	w.Header().Set("Content-Type", "application/json")
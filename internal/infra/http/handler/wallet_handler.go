@@ -14,20 +14,26 @@ import (
 type WalletHandler struct {
 	createWalletUC *usecase.CreateWalletUseCase
 	getWalletUC    *usecase.GetWalletUseCase
+	reconcileUC    *usecase.ReconcileWalletUseCase
 }
 
 func NewWalletHandler(
 	createWalletUC *usecase.CreateWalletUseCase,
 	getWalletUC *usecase.GetWalletUseCase,
+	reconcileUC *usecase.ReconcileWalletUseCase,
 ) *WalletHandler {
 	return &WalletHandler{
 		createWalletUC: createWalletUC,
 		getWalletUC:    getWalletUC,
+		reconcileUC:    reconcileUC,
 	}
 }
 
 func (h *WalletHandler) Create(w http.ResponseWriter, r *http.Request) {
 	var req struct {
+		CustomerID int64  `json:"customer_id"` // Titular da carteira (obrigatório)
+		Currency   string `json:"currency"`    // ISO 4217, padrão BRL
+		// Não é mais aceito (a carteira nasce zerada): só lido para recusar com uma mensagem clara
 		Balance int64 `json:"balance"`
 	}
 
@@ -35,11 +41,28 @@ func (h *WalletHandler) Create(w http.ResponseWriter, r *http.Request) {
 		respondError(w, http.StatusBadRequest, "Payload inválido")
 		return
 	}
+	if req.Balance != 0 {
+		respondError(w, http.StatusBadRequest, "A carteira é criada com saldo zero: use POST /admin/wallets/{id}/deposits")
+		return
+	}
 
 	output, err := h.createWalletUC.Execute(r.Context(), usecase.CreateWalletInput{
-		Balance: req.Balance,
+		CustomerID: req.CustomerID,
+		Currency:   req.Currency,
 	})
 	if err != nil {
+		if err == domain.ErrUnsupportedCurrency {
+			respondError(w, http.StatusBadRequest, "Moeda não suportada")
+			return
+		}
+		if err == domain.ErrCustomerRequired {
+			respondError(w, http.StatusBadRequest, "customer_id é obrigatório")
+			return
+		}
+		if err == domain.ErrCustomerNotFound {
+			respondError(w, http.StatusNotFound, "Cliente não encontrado")
+			return
+		}
 		log.Error().Err(err).Msg("Falha ao criar carteira")
 		respondError(w, http.StatusInternalServerError, "Erro interno")
 		return
@@ -70,3 +93,26 @@ func (h *WalletHandler) Get(w http.ResponseWriter, r *http.Request) {
 
 	respondJSON(w, http.StatusOK, output)
 }
+
+// Reconcile compara o saldo da carteira com o saldo reconstruído pelas partidas do ledger
+func (h *WalletHandler) Reconcile(w http.ResponseWriter, r *http.Request) {
+	idStr := chi.URLParam(r, "id")
+	walletID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		respondError(w, http.StatusBadRequest, "ID da carteira inválido")
+		return
+	}
+
+	output, err := h.reconcileUC.Execute(r.Context(), walletID)
+	if err != nil {
+		if err == domain.ErrWalletNotFound {
+			respondError(w, http.StatusNotFound, "Carteira não encontrada")
+			return
+		}
+		log.Error().Err(err).Msg("Erro ao conciliar carteira")
+		respondError(w, http.StatusInternalServerError, "Erro interno")
+		return
+	}
+
+	respondJSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// AdminAuth protege as rotas administrativas com um token estático (ADMIN_TOKEN).
+// Aceita "Authorization: Bearer <token>" ou "X-Admin-Token: <token>".
+// Sem token configurado as rotas ficam desabilitadas (Fail Closed).
+func AdminAuth(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if token == "" {
+				writeJSONError(w, http.StatusServiceUnavailable, "Rotas administrativas desabilitadas")
+				return
+			}
+
+			provided := r.Header.Get("X-Admin-Token")
+			if provided == "" {
+				provided = strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			}
+
+			// Comparação em tempo constante para não vazar o token por timing
+			if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				log.Warn().Str("path", r.URL.Path).Msg("Tentativa de acesso administrativo negada")
+				writeJSONError(w, http.StatusUnauthorized, "Não autorizado")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// writeJSONError segue o mesmo formato de erro dos handlers ({"error": "..."})
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write([]byte(`{"error":"` + message + `"}` + "\n")); err != nil {
+		log.Error().Err(err).Msg("Falha ao escrever resposta de erro")
+	}
+}
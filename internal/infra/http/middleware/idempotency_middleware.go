@@ -2,84 +2,460 @@ package middleware
 
 import (
 	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/go-chi/chi/v5"
 	"github.com/rs/zerolog/log"
 )
 
 // responseRecorder é um "espião" que grava o que o handler escreve
 type responseRecorder struct {
 	http.ResponseWriter
-	statusCode int
-	body       *bytes.Buffer
+	header      http.Header // Headers do handler, copiados para o cliente no WriteHeader
+	statusCode  int
+	body        *bytes.Buffer
+	wroteHeader bool
+	// buffered segura a resposta até o Commit: o cliente não pode ver um sucesso que ainda pode ser desfeito
+	buffered bool
+}
+
+func newResponseRecorder(w http.ResponseWriter) *responseRecorder {
+	return &responseRecorder{
+		ResponseWriter: w,
+		header:         http.Header{},
+		statusCode:     http.StatusOK, // Default
+		body:           &bytes.Buffer{},
+	}
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
 }
 
 func (r *responseRecorder) WriteHeader(statusCode int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
 	r.statusCode = statusCode
-	r.ResponseWriter.WriteHeader(statusCode)
+	if !r.buffered {
+		r.sendHeader()
+	}
 }
 
 func (r *responseRecorder) Write(b []byte) (int, error) {
-	r.body.Write(b)                  // Grava no nosso buffer
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+	r.body.Write(b) // Grava no nosso buffer
+	if r.buffered {
+		return len(b), nil
+	}
 	return r.ResponseWriter.Write(b) // Manda pro cliente
 }
 
-func Idempotency(store gateway.IdempotencyRepository) func(http.Handler) http.Handler {
+func (r *responseRecorder) sendHeader() {
+	for name, values := range r.header {
+		r.ResponseWriter.Header()[name] = values
+	}
+	r.ResponseWriter.WriteHeader(r.statusCode)
+}
+
+// flush manda para o cliente a resposta segurada
+func (r *responseRecorder) flush() {
+	r.sendHeader()
+	if _, err := r.ResponseWriter.Write(r.body.Bytes()); err != nil {
+		log.Error().Err(err).Msg("Falha ao escrever resposta")
+	}
+}
+
+// unreplayableHeaders não são gravados: dizem respeito à conexão ou ao momento da resposta original
+var unreplayableHeaders = map[string]bool{
+	"Connection":        true,
+	"Content-Length":    true,
+	"Date":              true,
+	"Keep-Alive":        true,
+	"Set-Cookie":        true,
+	"Transfer-Encoding": true,
+}
+
+// replayableHeaders copia os headers que a resposta original tinha e que a retentativa deve receber
+func replayableHeaders(header http.Header) map[string][]string {
+	headers := make(map[string][]string, len(header))
+	for name, values := range header {
+		if !unreplayableHeaders[name] {
+			headers[name] = append([]string(nil), values...)
+		}
+	}
+	return headers
+}
+
+// IdempotencyConfig ajusta o middleware de idempotência. Campos zerados usam o padrão.
+type IdempotencyConfig struct {
+	TTL time.Duration // Por quanto tempo a resposta fica guardada (padrão 24h)
+	// Validade da reserva "em processamento" (padrão 90s): precisa passar do timeout das requisições,
+	// senão uma requisição lenta perde a reserva e uma duplicada executa em paralelo
+	Lease time.Duration
+	// Quanto uma requisição duplicada espera pelo resultado da primeira antes de receber 409 (padrão 0: 409 na hora)
+	Wait time.Duration
+	// ClientID identifica o cliente autenticado da requisição: as chaves de clientes diferentes não colidem
+	// (padrão: hash da credencial enviada, ver credentialClientID)
+	ClientID func(r *http.Request) string
+	// RouteTTL sobrescreve o TTL por rota, no formato "MÉTODO padrão" (ex: "POST /transfers")
+	RouteTTL map[string]time.Duration
+	// RequiredRoutes são as rotas ("MÉTODO padrão") que recusam requisições sem Idempotency-Key (400)
+	RequiredRoutes map[string]bool
+	// SkipRoutes são as rotas ("MÉTODO padrão") que passam direto, sem idempotência nem transação
+	SkipRoutes map[string]bool
+	// FailClosed: com o armazenamento de idempotência fora do ar, recusa a requisição (503) em vez de
+	// processá-la sem proteção contra duplicidade (padrão false: Fail Open)
+	FailClosed bool
+}
+
+const (
+	defaultIdempotencyTTL   = 24 * time.Hour
+	defaultIdempotencyLease = 90 * time.Second
+	// Intervalo entre as consultas de quem espera o resultado da requisição em processamento
+	idempotencyPollInterval = 100 * time.Millisecond
+)
+
+// errHandlerFailed desfaz a transação da requisição quando a resposta não deve ser gravada (ver storableStatus)
+var errHandlerFailed = errors.New("handler responded with unstorable status")
+
+// anonymousClientID é o cliente das requisições sem credencial (ver credentialClientID)
+const anonymousClientID = "anonymous"
+
+// Idempotency grava a resposta de requisições com Idempotency-Key e a devolve nas retentativas.
+// Vale para todo método que altera estado: GET, HEAD e OPTIONS passam direto. Pode ficar antes do
+// roteamento (router.Use ou Group): a rota da chave é descoberta pelo próprio chi. Precisa vir depois da
+// autenticação da rota (ex: AdminAuth), senão uma requisição recusada reserva a chave e abre uma transação.
+// Requisições sem credencial não usam Idempotency-Key: todas cairiam no mesmo escopo e uma veria a resposta da outra.
+// Com txManager, o handler roda dentro de uma transação do banco e a resposta é gravada nela:
+// a operação e a resposta são efetivadas (ou desfeitas) juntas, e os eventos publicados pelos casos
+// de uso só saem depois do Commit (gateway.PendingEvents). Por isso os casos de uso das rotas que
+// alteram estado gravam sempre pelo Unit of Work (Uow.Run + WithTx): um que fosse direto ao pool
+// ocuparia uma segunda conexão enquanto a da requisição espera e não seria efetivado com a resposta.
+// Sem txManager, a resposta é gravada depois.
+func Idempotency(store gateway.IdempotencyRepository, txManager gateway.TransactionManager, config IdempotencyConfig) func(http.Handler) http.Handler {
+	if config.TTL <= 0 {
+		config.TTL = defaultIdempotencyTTL
+	}
+	if config.Lease <= 0 {
+		config.Lease = defaultIdempotencyLease
+	}
+	if config.ClientID == nil {
+		config.ClientID = credentialClientID
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			key := r.Header.Get("Idempotency-Key")
-			if key == "" {
+			// O cliente fica no contexto em toda requisição: a consulta de transação pela chave
+			// (GET /transactions) só procura no escopo de quem pergunta
+			clientID := config.ClientID(r)
+			r = r.WithContext(gateway.ContextWithIdempotencyKey(r.Context(), gateway.IdempotencyKey{ClientID: clientID}))
+
+			if isSafeMethod(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			route, found := routeOf(r)
+			if !found || config.SkipRoutes[route] {
+				// Rota inexistente (o chi responde 404/405) ou fora da idempotência: não há o que proteger
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			key := gateway.IdempotencyKey{Key: r.Header.Get("Idempotency-Key"), Route: route}
+			if key.Key == "" {
+				if config.RequiredRoutes[key.Route] {
+					writeJSONError(w, http.StatusBadRequest, "Header Idempotency-Key é obrigatório nesta rota")
+					return
+				}
 				// Se não tem chave, segue
 				next.ServeHTTP(w, r)
 				return
 			}
 
+			if clientID == anonymousClientID {
+				writeJSONError(w, http.StatusUnauthorized, "Idempotency-Key exige uma credencial (Authorization ou X-API-Key)")
+				return
+			}
+
+			// A chave vale dentro do cliente e da rota: a mesma chave em outro escopo é outra operação.
+			// Os handlers gravam a transação com este escopo (transactions.idempotency_scope).
+			key.ClientID = clientID
+			r = r.WithContext(gateway.ContextWithIdempotencyKey(r.Context(), key))
 			ctx := r.Context()
+			ttl := config.TTL
+			if routeTTL, ok := config.RouteTTL[key.Route]; ok {
+				ttl = routeTTL
+			}
 
-			// Verificar no Redis
+			// Lemos o corpo para calcular a impressão digital e o devolvemos intacto para o handler
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				writeJSONError(w, http.StatusBadRequest, "Falha ao ler o corpo da requisição")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			fingerprint := requestFingerprint(r, body)
+
+			// Verificar se já respondemos esta chave
 			cached, err := store.Get(ctx, key)
 			if err != nil {
 				log.Error().Err(err).Msg("Falha ao buscar chave de idempotência")
-				// Em caso de erro no Redis, deixamos passar para não travar a API (Fail Open)
-				next.ServeHTTP(w, r)
+				// Fail Open: deixamos passar para não travar a API. Fail Closed: recusamos.
+				storeUnavailable(w, r, next, config)
 				return
 			}
-
-			// Cache Hit: Retornar o que já tínhamos gravado
 			if cached != nil {
-				log.Info().Str("key", key).Msg("Idempotency cache hit")
-				w.Header().Set("Content-Type", "application/json")
-				w.Header().Set("X-Idempotency-Hit", "true")
-				w.WriteHeader(cached.StatusCode)
-				if _, err := w.Write(cached.Body); err != nil {
-					log.Error().Err(err).Msg("Falha ao escrever resposta cacheada")
+				replay(w, r, key, fingerprint, cached)
+				return
+			}
+
+			// Cache Miss: reservamos a chave antes de processar, para que uma duplicada simultânea
+			// não execute junto. A reserva é gravada fora da transação, para as outras a enxergarem. Se a requisição cair, a reserva expira em config.Lease.
+			lock := gateway.IdempotencyLock{Token: rand.Text(), Fingerprint: fingerprint}
+			deadline := time.Now().Add(config.Wait)
+			for {
+				acquired, holder, err := store.Reserve(ctx, key, lock, config.Lease)
+				if err != nil {
+					log.Error().Err(err).Msg("Falha ao reservar chave de idempotência")
+					storeUnavailable(w, r, next, config) // Como no Get
+					return
+				}
+				if acquired {
+					break
+				}
+				if holder != nil && holder.Fingerprint != fingerprint {
+					writeFingerprintMismatch(w, r, key)
+					return
+				}
+				// A primeira pode ter terminado entre o Get e a reserva
+				if cached, err = store.Get(ctx, key); err == nil && cached != nil {
+					replay(w, r, key, fingerprint, cached)
+					return
+				}
+				if holder == nil {
+					continue // A reserva acabou de ser liberada: tentamos de novo
+				}
+				if !time.Now().Before(deadline) {
+					log.Info().Str("key", key.Key).Msg("Idempotency-Key em processamento por outra requisição")
+					w.Header().Set("Retry-After", "1")
+					writeJSONError(w, http.StatusConflict, "Outra requisição com esta Idempotency-Key ainda está em processamento")
+					return
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(idempotencyPollInterval):
 				}
+			}
+
+			// Com a reserva em mãos, conferimos de novo: a primeira pode ter gravado a resposta e
+			// liberado a reserva entre o nosso Get e o SET NX
+			if cached, err := store.Get(ctx, key); err == nil && cached != nil {
+				releaseLock(store, key, lock)
+				replay(w, r, key, fingerprint, cached)
 				return
 			}
 
-			// Cache Miss: Processar a requisição e gravar a resposta
-			recorder := &responseRecorder{
-				ResponseWriter: w,
-				statusCode:     http.StatusOK, // Default
-				body:           &bytes.Buffer{},
+			// Processar a requisição e gravar a resposta
+			recorder := newResponseRecorder(w)
+
+			// Libera a reserva mesmo se o handler entrar em pânico (o Recoverer responde 500 depois)
+			defer releaseLock(store, key, lock)
+
+			response := func() gateway.CachedResponse {
+				return gateway.CachedResponse{
+					StatusCode:  recorder.statusCode,
+					Body:        recorder.body.Bytes(),
+					Headers:     replayableHeaders(recorder.header),
+					Fingerprint: fingerprint,
+				}
 			}
 
-			next.ServeHTTP(recorder, r)
+			if txManager == nil {
+				next.ServeHTTP(recorder, r)
 
-			// Salvar no Redis (Apenas sucessos 2xx ou erros de cliente 4xx que não devem mudar)
-			// Erros 500 geralmente não queremos cachear para permitir retry.
-			if recorder.statusCode < 500 {
-				err := store.Save(ctx, key, gateway.CachedResponse{
-					StatusCode: recorder.statusCode,
-					Body:       recorder.body.Bytes(),
-				}, 24*time.Hour) // TTL de 24h
+				// A resposta é gravada antes de liberar a reserva: quem está esperando já a encontra.
+				if storableStatus(recorder.statusCode) {
+					if err := store.Save(ctx, key, response(), ttl); err != nil {
+						log.Error().Err(err).Msg("Falha ao salvar chave de idempotência")
+					}
+				}
+				return
+			}
 
-				if err != nil {
-					log.Error().Err(err).Msg("Falha ao salvar chave de idempotência")
+			// O handler recebe o contexto com a transação: os casos de uso abrem SAVEPOINTs nela
+			// (Uow.Run) e o Commit só acontece aqui, junto com a resposta gravada.
+			// Os eventos publicados pelos casos de uso ficam segurados até este Commit.
+			recorder.buffered = true
+			ctxEvents, pendingEvents := gateway.ContextWithPendingEvents(ctx)
+			var saveErr error
+			err = txManager.Run(ctxEvents, func(ctxTx context.Context) error {
+				next.ServeHTTP(recorder, r.WithContext(ctxTx))
+				if !storableStatus(recorder.statusCode) {
+					return errHandlerFailed // Rollback: a retentativa executa de novo
+				}
+
+				// Save num SAVEPOINT próprio: se falhar, não aborta a transação da operação
+				saveErr = txManager.Run(ctxTx, func(ctxSave context.Context) error {
+					return store.WithTx(ctxSave.Value(gateway.TransactionKey)).Save(ctxSave, key, response(), ttl)
+				})
+				if saveErr != nil {
+					log.Error().Err(saveErr).Msg("Falha ao salvar chave de idempotência")
+					if config.FailClosed {
+						return saveErr // Sem a resposta gravada, a operação também não é efetivada
+					}
 				}
+				return nil
+			})
+
+			switch {
+			case err == nil:
+				recorder.flush()
+				// O cliente pode ter desconectado: os eventos do que foi efetivado saem mesmo assim
+				pendingEvents.Flush(context.WithoutCancel(ctx))
+			case errors.Is(err, errHandlerFailed):
+				recorder.flush() // Rollback: os eventos segurados são descartados
+			case config.FailClosed && saveErr != nil:
+				writeJSONError(w, http.StatusServiceUnavailable, "Serviço de idempotência indisponível")
+			default:
+				log.Error().Err(err).Str("key", key.Key).Msg("Falha ao efetivar transação da requisição idempotente")
+				writeJSONError(w, http.StatusInternalServerError, "Erro interno")
 			}
 		})
 	}
 }
+
+// storeUnavailable aplica o modo de falha quando o armazenamento de idempotência não responde
+func storeUnavailable(w http.ResponseWriter, r *http.Request, next http.Handler, config IdempotencyConfig) {
+	if config.FailClosed {
+		writeJSONError(w, http.StatusServiceUnavailable, "Serviço de idempotência indisponível")
+		return
+	}
+	next.ServeHTTP(w, r)
+}
+
+// replay devolve a resposta gravada, se ela for da mesma requisição
+func replay(w http.ResponseWriter, r *http.Request, key gateway.IdempotencyKey, fingerprint string, cached *gateway.CachedResponse) {
+	// Mesma chave com outra requisição (método, rota ou corpo diferentes): é um erro do cliente,
+	// não uma retentativa. 422 conforme o draft IETF "The Idempotency-Key HTTP Header Field".
+	if cached.Fingerprint != "" && cached.Fingerprint != fingerprint {
+		writeFingerprintMismatch(w, r, key)
+		return
+	}
+
+	// Cache Hit: Retornar o que já tínhamos gravado
+	log.Info().Str("key", key.Key).Msg("Idempotency cache hit")
+	for name, values := range cached.Headers {
+		w.Header()[name] = values
+	}
+	if len(cached.Headers) == 0 {
+		w.Header().Set("Content-Type", "application/json") // Respostas gravadas antes de guardarmos os headers
+	}
+	w.Header().Set("X-Idempotency-Hit", "true")
+	w.WriteHeader(cached.StatusCode)
+	if _, err := w.Write(cached.Body); err != nil {
+		log.Error().Err(err).Msg("Falha ao escrever resposta cacheada")
+	}
+}
+
+func writeFingerprintMismatch(w http.ResponseWriter, r *http.Request, key gateway.IdempotencyKey) {
+	log.Warn().Str("key", key.Key).Str("path", r.URL.Path).Msg("Idempotency-Key reutilizada com outra requisição")
+	writeJSONError(w, http.StatusUnprocessableEntity, "Idempotency-Key já utilizada com outra requisição (método, rota ou corpo diferentes)")
+}
+
+// releaseLock usa um contexto próprio: o da requisição pode já ter sido cancelado (cliente desconectou)
+func releaseLock(store gateway.IdempotencyRepository, key gateway.IdempotencyKey, lock gateway.IdempotencyLock) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := store.Release(ctx, key, lock); err != nil {
+		// A reserva expira sozinha em config.Lease
+		log.Error().Err(err).Str("key", key.Key).Msg("Falha ao liberar chave de idempotência")
+	}
+}
+
+// storableStatus indica se a resposta vale para as retentativas com a mesma chave.
+// Sucessos 2xx e erros de cliente 4xx não mudam numa retentativa. Erros 5xx não são gravados, para permitir o retry,
+// nem 401/403: a credencial pode ser corrigida e a requisição reenviada com a mesma chave.
+func storableStatus(status int) bool {
+	return status < 500 && status != http.StatusUnauthorized && status != http.StatusForbidden
+}
+
+// isSafeMethod: métodos que não alteram estado não precisam de idempotência
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// routeOf retorna o método e o padrão da rota (ex: "POST /holds/{id}/capture"), que não muda com os IDs.
+// Antes do roteamento (router.Use ou middleware de um subrouter) o padrão ainda não está no contexto:
+// perguntamos ao router, com um contexto de rota próprio para não mexer no da requisição.
+// Retorna false se a rota não existe para o método.
+func routeOf(r *http.Request) (string, bool) {
+	rctx := chi.RouteContext(r.Context())
+	if rctx == nil || rctx.Routes == nil {
+		return r.Method + " " + r.URL.Path, true // Fora do chi: o caminho identifica a rota
+	}
+	pattern := rctx.Routes.Find(chi.NewRouteContext(), r.Method, r.URL.Path)
+	if pattern == "" {
+		return "", false
+	}
+	return r.Method + " " + pattern, true
+}
+
+// credentialClientID separa as chaves pela credencial da requisição (Authorization, X-API-Key ou X-Admin-Token).
+// A autenticação dos clientes fica no gateway na frente da API; guardamos só um hash da credencial.
+// Requisições sem credencial são do cliente anonymousClientID.
+func credentialClientID(r *http.Request) string {
+	credential := r.Header.Get("Authorization")
+	if credential == "" {
+		credential = r.Header.Get("X-API-Key")
+	}
+	if credential == "" {
+		credential = r.Header.Get("X-Admin-Token")
+	}
+	if credential == "" {
+		return anonymousClientID
+	}
+	sum := sha256.Sum256([]byte(credential))
+	return "credential:" + hex.EncodeToString(sum[:16])
+}
+
+// requestFingerprint identifica a requisição: SHA-256 de método, rota (com a query ordenada) e corpo.
+// Corpos JSON são canonizados (chaves ordenadas, sem espaços), então só a formatação diferente
+// não conta como outra requisição.
+func requestFingerprint(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + "\n" + r.URL.Path + "\n" + r.URL.Query().Encode() + "\n"))
+	hash.Write(canonicalBody(body))
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// canonicalBody reescreve um corpo JSON com as chaves em ordem alfabética e sem espaços.
+// Números são mantidos como foram enviados (UseNumber): 100 e 100.0 são corpos diferentes.
+// Corpos que não são JSON entram como vieram.
+func canonicalBody(body []byte) []byte {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return body
+	}
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return canonical
+}
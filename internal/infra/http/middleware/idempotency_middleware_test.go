@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "chaves em ordem alfabética", body: `{"to_wallet_id":2,"amount":1000,"from_wallet_id":1}`, want: `{"amount":1000,"from_wallet_id":1,"to_wallet_id":2}`},
+		{name: "espaços e quebras de linha", body: "{\n  \"b\": 1,\n  \"a\": \"x y\"\n}\n", want: `{"a":"x y","b":1}`},
+		{name: "objetos aninhados e listas", body: `{"legs":[{"wallet_id":2,"amount":-5},{"amount":5,"wallet_id":1}],"description":"ajuste"}`, want: `{"description":"ajuste","legs":[{"amount":-5,"wallet_id":2},{"amount":5,"wallet_id":1}]}`},
+		{name: "ordem das listas é mantida", body: `[3,1,2]`, want: `[3,1,2]`},
+		{name: "números como foram enviados", body: `{"amount":100.0,"rate":1e2}`, want: `{"amount":100.0,"rate":1e2}`},
+		{name: "inteiro grande sem perder precisão", body: `{"amount":9007199254740993}`, want: `{"amount":9007199254740993}`},
+		{name: "corpo vazio", body: ``, want: ``},
+		{name: "não JSON entra como veio", body: `amount=10&b=2`, want: `amount=10&b=2`},
+		{name: "dois valores JSON entram como vieram", body: `{"b":1} {"a":2}`, want: `{"b":1} {"a":2}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(canonicalBody([]byte(tt.body))); got != tt.want {
+				t.Errorf("canonicalBody(%q) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequestFingerprint(t *testing.T) {
+	const (
+		target = "/transfers"
+		body   = `{"from_wallet_id":1,"to_wallet_id":2,"amount":1000}`
+	)
+
+	tests := []struct {
+		name     string
+		method   string
+		target   string
+		body     string
+		wantSame bool // Mesma impressão digital da requisição de referência
+	}{
+		{name: "mesma requisição", method: "POST", target: target, body: body, wantSame: true},
+		{name: "chaves em outra ordem", method: "POST", target: target, body: `{"amount":1000,"to_wallet_id":2,"from_wallet_id":1}`, wantSame: true},
+		{name: "só a formatação muda", method: "POST", target: target, body: "{ \"from_wallet_id\": 1,\n \"to_wallet_id\": 2,\n \"amount\": 1000 }", wantSame: true},
+		{name: "com query string", method: "POST", target: target + "?dry_run=true", body: body},
+		{name: "outro valor", method: "POST", target: target, body: `{"from_wallet_id":1,"to_wallet_id":2,"amount":1001}`},
+		{name: "outro tipo do mesmo valor", method: "POST", target: target, body: `{"from_wallet_id":1,"to_wallet_id":2,"amount":"1000"}`},
+		{name: "campo a mais", method: "POST", target: target, body: `{"from_wallet_id":1,"to_wallet_id":2,"amount":1000,"currency":"BRL"}`},
+		{name: "outro método", method: "PUT", target: target, body: body},
+		{name: "outro caminho", method: "POST", target: "/transfer-batches", body: body},
+	}
+
+	reference := requestFingerprint(httptest.NewRequest("POST", target, nil), []byte(body))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			got := requestFingerprint(r, []byte(tt.body))
+			if same := got == reference; same != tt.wantSame {
+				t.Errorf("same fingerprint = %v, want %v", same, tt.wantSame)
+			}
+		})
+	}
+
+	t.Run("query com os mesmos parâmetros em outra ordem", func(t *testing.T) {
+		a := requestFingerprint(httptest.NewRequest("POST", target+"?a=1&b=2", nil), []byte(body))
+		b := requestFingerprint(httptest.NewRequest("POST", target+"?b=2&a=1", nil), []byte(body))
+		if a != b {
+			t.Error("fingerprints differ only by query parameter order")
+		}
+	})
+}
+
+func TestIdempotencyAnonymousClient(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		key        string
+		wantStatus int
+		wantCalled bool // O handler da rota foi chamado
+	}{
+		{name: "chave sem credencial é recusada", method: "POST", key: "k-1", wantStatus: http.StatusUnauthorized},
+		{name: "sem chave segue sem idempotência", method: "POST", wantStatus: http.StatusCreated, wantCalled: true},
+		{name: "método seguro passa direto", method: "GET", key: "k-1", wantStatus: http.StatusCreated, wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				w.WriteHeader(http.StatusCreated)
+			})
+			// Sem armazenamento: a requisição anônima precisa ser resolvida antes de qualquer acesso a ele
+			handler := Idempotency(nil, nil, IdempotencyConfig{})(next)
+
+			r := httptest.NewRequest(tt.method, "/transfers", strings.NewReader(`{"amount":1000}`))
+			if tt.key != "" {
+				r.Header.Set("Idempotency-Key", tt.key)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.wantStatus || called != tt.wantCalled {
+				t.Errorf("status = %d, handler called = %v, want %d, %v", w.Code, called, tt.wantStatus, tt.wantCalled)
+			}
+		})
+	}
+}
+
+func TestStorableStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		want   bool
+	}{
+		{status: http.StatusOK, want: true},
+		{status: http.StatusCreated, want: true},
+		{status: http.StatusBadRequest, want: true},
+		{status: http.StatusUnauthorized},
+		{status: http.StatusForbidden},
+		{status: http.StatusUnprocessableEntity, want: true},
+		{status: http.StatusInternalServerError},
+		{status: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			if got := storableStatus(tt.status); got != tt.want {
+				t.Errorf("storableStatus(%d) = %v, want %v", tt.status, got, tt.want)
+			}
+		})
+	}
+}
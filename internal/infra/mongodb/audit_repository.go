@@ -16,8 +16,30 @@ type AuditLog struct {
 	FromWallet    int64     `bson:"from_wallet"`
 	ToWallet      int64     `bson:"to_wallet"`
 	Amount        int64     `bson:"amount"`
+	FeeAmount     int64     `bson:"fee_amount"`
+	Currency      string    `bson:"currency"`
 	Status        string    `bson:"status"`
 	ProcessedAt   time.Time `bson:"processed_at"`
+
+	// Câmbio (vazio em transferências na mesma moeda)
+	DestinationAmount   int64  `bson:"destination_amount,omitempty"`
+	DestinationCurrency string `bson:"destination_currency,omitempty"`
+	FXRate              string `bson:"fx_rate,omitempty"`
+	FXQuoteID           string `bson:"fx_quote_id,omitempty"`
+
+	// Estorno: aponta para a transação estornada
+	OriginalTransactionID string `bson:"original_transaction_id,omitempty"`
+
+	// Lançamento journal: as pernas substituem from/to
+	Kind        string     `bson:"kind,omitempty"`
+	Description string     `bson:"description,omitempty"`
+	Legs        []AuditLeg `bson:"legs,omitempty"`
+}
+
+type AuditLeg struct {
+	WalletID int64  `bson:"wallet_id"`
+	Amount   int64  `bson:"amount"`
+	Currency string `bson:"currency"`
 }
 
 type AuditRepository struct {
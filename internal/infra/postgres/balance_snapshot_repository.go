@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// BalanceSnapshotRepository implementa gateway.BalanceSnapshotRepository usando pgx/v5
+type BalanceSnapshotRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewBalanceSnapshotRepository(pool *pgxpool.Pool) *BalanceSnapshotRepository {
+	return &BalanceSnapshotRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *BalanceSnapshotRepository) CreateForDate(ctx context.Context, date time.Time, closesAt time.Time) (int64, error) {
+	created, err := r.queries.CreateBalanceSnapshots(ctx, db.CreateBalanceSnapshotsParams{
+		SnapshotDate: dateToPgType(date),
+		ClosesAt:     pgtype.Timestamptz{Time: closesAt, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create balance snapshots: %w", err)
+	}
+	return created, nil
+}
+
+func (r *BalanceSnapshotRepository) GetLatest(ctx context.Context, walletID int64, at time.Time) (*domain.BalanceSnapshot, error) {
+	row, err := r.queries.GetLatestBalanceSnapshot(ctx, db.GetLatestBalanceSnapshotParams{
+		WalletID: walletID,
+		At:       pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get balance snapshot: %w", err)
+	}
+
+	return &domain.BalanceSnapshot{
+		WalletID:  row.WalletID,
+		Date:      pgTypeToDate(row.SnapshotDate),
+		ClosesAt:  row.ClosesAt.Time,
+		Balance:   row.Balance,
+		CreatedAt: row.CreatedAt.Time,
+	}, nil
+}
+
+func (r *BalanceSnapshotRepository) WithTx(tx gateway.TransactionObject) gateway.BalanceSnapshotRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &BalanceSnapshotRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
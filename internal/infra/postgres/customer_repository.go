@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CustomerRepository implementa gateway.CustomerRepository usando pgx/v5
+type CustomerRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewCustomerRepository(pool *pgxpool.Pool) *CustomerRepository {
+	return &CustomerRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *CustomerRepository) Create(ctx context.Context, customer *domain.Customer) error {
+	row, err := r.queries.CreateCustomer(ctx, db.CreateCustomerParams{
+		Name:         customer.Name,
+		DocumentType: customer.DocumentType,
+		Document:     customer.Document,
+		Email:        customer.Email,
+		Phone:        phoneToPgType(customer.Phone),
+	})
+	if err != nil {
+		// 23505 = unique_violation: documento já cadastrado
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrDocumentAlreadyExists
+		}
+		return fmt.Errorf("failed to create customer: %w", err)
+	}
+
+	*customer = *toDomainCustomer(row)
+	return nil
+}
+
+func (r *CustomerRepository) GetByID(ctx context.Context, id int64) (*domain.Customer, error) {
+	row, err := r.queries.GetCustomer(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCustomerNotFound
+		}
+		return nil, fmt.Errorf("failed to get customer: %w", err)
+	}
+	return toDomainCustomer(row), nil
+}
+
+func (r *CustomerRepository) List(ctx context.Context, afterID int64, limit int32) ([]domain.Customer, error) {
+	rows, err := r.queries.ListCustomers(ctx, db.ListCustomersParams{
+		AfterID:  afterID,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customers: %w", err)
+	}
+
+	customers := make([]domain.Customer, 0, len(rows))
+	for _, row := range rows {
+		customers = append(customers, *toDomainCustomer(row))
+	}
+	return customers, nil
+}
+
+func (r *CustomerRepository) Update(ctx context.Context, customer *domain.Customer) error {
+	row, err := r.queries.UpdateCustomer(ctx, db.UpdateCustomerParams{
+		ID:        customer.ID,
+		Name:      customer.Name,
+		Email:     customer.Email,
+		Phone:     phoneToPgType(customer.Phone),
+		KycStatus: customer.KYCStatus,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrCustomerNotFound
+		}
+		return fmt.Errorf("failed to update customer: %w", err)
+	}
+
+	*customer = *toDomainCustomer(row)
+	return nil
+}
+
+func (r *CustomerRepository) Delete(ctx context.Context, id int64) error {
+	rows, err := r.queries.DeleteCustomer(ctx, id)
+	if err != nil {
+		// 23503 = foreign_key_violation: ainda existem carteiras do cliente
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrCustomerHasWallets
+		}
+		return fmt.Errorf("failed to delete customer: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrCustomerNotFound
+	}
+	return nil
+}
+
+func (r *CustomerRepository) WithTx(tx gateway.TransactionObject) gateway.CustomerRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &CustomerRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainCustomer(c db.Customer) *domain.Customer {
+	return &domain.Customer{
+		ID:           c.ID,
+		Name:         c.Name,
+		DocumentType: c.DocumentType,
+		Document:     c.Document,
+		Email:        c.Email,
+		Phone:        c.Phone.String,
+		KYCStatus:    c.KycStatus,
+		CreatedAt:    c.CreatedAt.Time,
+		UpdatedAt:    c.UpdatedAt.Time,
+	}
+}
+
+// Helper para converter o telefone opcional (vazio = NULL)
+func phoneToPgType(phone string) pgtype.Text {
+	return pgtype.Text{String: phone, Valid: phone != ""}
+}
@@ -8,20 +8,238 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type Transaction struct {
+type BalanceSnapshot struct {
+	WalletID     int64              `json:"wallet_id"`
+	SnapshotDate pgtype.Date        `json:"snapshot_date"`
+	ClosesAt     pgtype.Timestamptz `json:"closes_at"`
+	Balance      int64              `json:"balance"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
+type Customer struct {
+	ID           int64              `json:"id"`
+	Name         string             `json:"name"`
+	DocumentType string             `json:"document_type"`
+	Document     string             `json:"document"`
+	Email        string             `json:"email"`
+	Phone        pgtype.Text        `json:"phone"`
+	KycStatus    string             `json:"kyc_status"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt    pgtype.Timestamptz `json:"updated_at"`
+}
+
+type Entry struct {
+	ID            int64              `json:"id"`
+	TransactionID pgtype.UUID        `json:"transaction_id"`
+	WalletID      int64              `json:"wallet_id"`
+	Amount        int64              `json:"amount"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	Currency      string             `json:"currency"`
+}
+
+type FeePolicy struct {
+	ID              int64              `json:"id"`
+	TierCode        string             `json:"tier_code"`
+	Currency        string             `json:"currency"`
+	Kind            string             `json:"kind"`
+	FlatAmount      int64              `json:"flat_amount"`
+	PercentageBps   int64              `json:"percentage_bps"`
+	MinAmount       pgtype.Int8        `json:"min_amount"`
+	MaxAmount       pgtype.Int8        `json:"max_amount"`
+	Brackets        []byte             `json:"brackets"`
+	RevenueWalletID pgtype.Int8        `json:"revenue_wallet_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+type FxQuote struct {
+	ID                  pgtype.UUID        `json:"id"`
+	SourceCurrency      string             `json:"source_currency"`
+	DestinationCurrency string             `json:"destination_currency"`
+	Rate                pgtype.Numeric     `json:"rate"`
+	SourceAmount        int64              `json:"source_amount"`
+	DestinationAmount   int64              `json:"destination_amount"`
+	ExpiresAt           pgtype.Timestamptz `json:"expires_at"`
+	UsedAt              pgtype.Timestamptz `json:"used_at"`
+	CreatedAt           pgtype.Timestamptz `json:"created_at"`
+}
+
+type Hold struct {
 	ID             pgtype.UUID        `json:"id"`
-	FromWalletID   int64              `json:"from_wallet_id"`
+	WalletID       int64              `json:"wallet_id"`
 	ToWalletID     int64              `json:"to_wallet_id"`
 	Amount         int64              `json:"amount"`
+	CapturedAmount int64              `json:"captured_amount"`
+	Currency       string             `json:"currency"`
 	Status         string             `json:"status"`
-	IdempotencyKey pgtype.Text        `json:"idempotency_key"`
+	TransactionID  pgtype.UUID        `json:"transaction_id"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
 	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type IdempotencyKey struct {
+	Key         string             `json:"key"`
+	Fingerprint string             `json:"fingerprint"`
+	Status      string             `json:"status"`
+	LockToken   pgtype.Text        `json:"lock_token"`
+	LockedUntil pgtype.Timestamptz `json:"locked_until"`
+	StatusCode  pgtype.Int4        `json:"status_code"`
+	Body        []byte             `json:"body"`
+	Headers     []byte             `json:"headers"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	CompletedAt pgtype.Timestamptz `json:"completed_at"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	ClientID    string             `json:"client_id"`
+	Route       string             `json:"route"`
+}
+
+type InterestAccount struct {
+	WalletID   int64              `json:"wallet_id"`
+	AnnualRate pgtype.Numeric     `json:"annual_rate"`
+	DayCount   string             `json:"day_count"`
+	StartDate  pgtype.Date        `json:"start_date"`
+	CarryUnits pgtype.Numeric     `json:"carry_units"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt  pgtype.Timestamptz `json:"updated_at"`
+}
+
+type InterestAccrual struct {
+	ID          int64              `json:"id"`
+	WalletID    int64              `json:"wallet_id"`
+	AccrualDate pgtype.Date        `json:"accrual_date"`
+	Balance     int64              `json:"balance"`
+	AnnualRate  pgtype.Numeric     `json:"annual_rate"`
+	DayCount    string             `json:"day_count"`
+	AmountUnits pgtype.Numeric     `json:"amount_units"`
+	PayoutID    pgtype.Int8        `json:"payout_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+}
+
+type InterestPayout struct {
+	ID            int64              `json:"id"`
+	WalletID      int64              `json:"wallet_id"`
+	PeriodStart   pgtype.Date        `json:"period_start"`
+	Amount        int64              `json:"amount"`
+	TransactionID pgtype.UUID        `json:"transaction_id"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+}
+
+type LimitTier struct {
+	Code              string             `json:"code"`
+	MaxSingleAmount   pgtype.Int8        `json:"max_single_amount"`
+	DailyAmount       pgtype.Int8        `json:"daily_amount"`
+	DailyCount        pgtype.Int8        `json:"daily_count"`
+	MonthlyAmount     pgtype.Int8        `json:"monthly_amount"`
+	MonthlyCount      pgtype.Int8        `json:"monthly_count"`
+	NightSingleAmount pgtype.Int8        `json:"night_single_amount"`
+	NightAmount       pgtype.Int8        `json:"night_amount"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ScheduledTransfer struct {
+	ID            pgtype.UUID        `json:"id"`
+	FromWalletID  int64              `json:"from_wallet_id"`
+	ToWalletID    int64              `json:"to_wallet_id"`
+	Amount        int64              `json:"amount"`
+	Currency      string             `json:"currency"`
+	ExecuteAt     pgtype.Timestamptz `json:"execute_at"`
+	Status        string             `json:"status"`
+	Attempts      int32              `json:"attempts"`
+	TransactionID pgtype.UUID        `json:"transaction_id"`
+	FailureReason pgtype.Text        `json:"failure_reason"`
+	ExecutedAt    pgtype.Timestamptz `json:"executed_at"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+}
+
+type StandingOrder struct {
+	ID                      pgtype.UUID        `json:"id"`
+	FromWalletID            int64              `json:"from_wallet_id"`
+	ToWalletID              int64              `json:"to_wallet_id"`
+	Amount                  int64              `json:"amount"`
+	Currency                string             `json:"currency"`
+	Rrule                   string             `json:"rrule"`
+	StartAt                 pgtype.Timestamptz `json:"start_at"`
+	InsufficientFundsPolicy string             `json:"insufficient_funds_policy"`
+	MaxRetries              int32              `json:"max_retries"`
+	RetryIntervalSeconds    int32              `json:"retry_interval_seconds"`
+	Status                  string             `json:"status"`
+	OccurrencesCount        int32              `json:"occurrences_count"`
+	NextOccurrenceAt        pgtype.Timestamptz `json:"next_occurrence_at"`
+	NextRunAt               pgtype.Timestamptz `json:"next_run_at"`
+	Attempts                int32              `json:"attempts"`
+	LastFailureReason       pgtype.Text        `json:"last_failure_reason"`
+	ClaimedUntil            pgtype.Timestamptz `json:"claimed_until"`
+	CreatedAt               pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt               pgtype.Timestamptz `json:"updated_at"`
+}
+
+type StandingOrderOccurrence struct {
+	ID              int64              `json:"id"`
+	StandingOrderID pgtype.UUID        `json:"standing_order_id"`
+	OccurrenceAt    pgtype.Timestamptz `json:"occurrence_at"`
+	Status          string             `json:"status"`
+	TransactionID   pgtype.UUID        `json:"transaction_id"`
+	FailureReason   pgtype.Text        `json:"failure_reason"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+type Transaction struct {
+	ID                    pgtype.UUID        `json:"id"`
+	FromWalletID          pgtype.Int8        `json:"from_wallet_id"`
+	ToWalletID            pgtype.Int8        `json:"to_wallet_id"`
+	Amount                int64              `json:"amount"`
+	Status                string             `json:"status"`
+	IdempotencyKey        pgtype.Text        `json:"idempotency_key"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	Currency              string             `json:"currency"`
+	DestinationAmount     pgtype.Int8        `json:"destination_amount"`
+	DestinationCurrency   pgtype.Text        `json:"destination_currency"`
+	FxRate                pgtype.Numeric     `json:"fx_rate"`
+	FxQuoteID             pgtype.UUID        `json:"fx_quote_id"`
+	RefundedAmount        int64              `json:"refunded_amount"`
+	OriginalTransactionID pgtype.UUID        `json:"original_transaction_id"`
+	Kind                  string             `json:"kind"`
+	Description           pgtype.Text        `json:"description"`
+	FeeAmount             int64              `json:"fee_amount"`
+	IdempotencyScope      string             `json:"idempotency_scope"`
 }
 
 type Wallet struct {
-	ID        int64              `json:"id"`
-	Balance   int64              `json:"balance"`
-	Version   int32              `json:"version"`
-	CreatedAt pgtype.Timestamptz `json:"created_at"`
-	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+	ID             int64              `json:"id"`
+	Balance        int64              `json:"balance"`
+	Version        int32              `json:"version"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	Currency       string             `json:"currency"`
+	SystemCode     pgtype.Text        `json:"system_code"`
+	HeldAmount     int64              `json:"held_amount"`
+	OverdraftLimit int64              `json:"overdraft_limit"`
+	Status         string             `json:"status"`
+	CustomerID     pgtype.Int8        `json:"customer_id"`
+}
+
+type WalletLimit struct {
+	WalletID          int64              `json:"wallet_id"`
+	TierCode          string             `json:"tier_code"`
+	MaxSingleAmount   pgtype.Int8        `json:"max_single_amount"`
+	DailyAmount       pgtype.Int8        `json:"daily_amount"`
+	DailyCount        pgtype.Int8        `json:"daily_count"`
+	MonthlyAmount     pgtype.Int8        `json:"monthly_amount"`
+	MonthlyCount      pgtype.Int8        `json:"monthly_count"`
+	NightSingleAmount pgtype.Int8        `json:"night_single_amount"`
+	NightAmount       pgtype.Int8        `json:"night_amount"`
+	UpdatedAt         pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WalletLimitChange struct {
+	ID            int64              `json:"id"`
+	WalletID      int64              `json:"wallet_id"`
+	PreviousLimit int64              `json:"previous_limit"`
+	NewLimit      int64              `json:"new_limit"`
+	ChangedBy     string             `json:"changed_by"`
+	Reason        string             `json:"reason"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
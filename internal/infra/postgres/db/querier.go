@@ -6,20 +6,142 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	CancelScheduledTransfer(ctx context.Context, id pgtype.UUID) (int64, error)
+	// Reserva os agendamentos vencidos para este scheduler (SKIP LOCKED permite várias instâncias).
+	// Pendentes em nova tentativa esperam next_attempt_at (backoff).
+	// Itens em "processing" há mais tempo que stale_before foram abandonados por um scheduler
+	// que caiu e voltam a ser executados: a Idempotency-Key impede pagamento em dobro.
+	ClaimDueScheduledTransfers(ctx context.Context, arg ClaimDueScheduledTransfersParams) ([]ScheduledTransfer, error)
+	// Reserva as ordens vencidas até claimed_until (SKIP LOCKED permite várias instâncias do scheduler).
+	// Se o scheduler cair, a reserva vence e outra instância reprocessa: a Idempotency-Key da ocorrência
+	// impede pagamento em dobro.
+	ClaimDueStandingOrders(ctx context.Context, arg ClaimDueStandingOrdersParams) ([]StandingOrder, error)
+	// Encerra a autorização (captured, voided ou expired). Só fecha se ainda estiver ativa.
+	CloseHold(ctx context.Context, arg CloseHoldParams) (int64, error)
+	// Fotografa o saldo de fim de dia das carteiras com partidas desde a última fotografia:
+	// saldo da fotografia anterior + partidas entre ela e closes_at. Carteiras sem movimento
+	// não ganham linha (a fotografia anterior continua valendo). Reexecutar para a mesma data não duplica.
+	CreateBalanceSnapshots(ctx context.Context, arg CreateBalanceSnapshotsParams) (int64, error)
+	CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Customer, error)
+	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFXQuote(ctx context.Context, arg CreateFXQuoteParams) (FxQuote, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (int64, error)
+	CreateInterestPayout(ctx context.Context, arg CreateInterestPayoutParams) (InterestPayout, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	CreateStandingOrder(ctx context.Context, arg CreateStandingOrderParams) (StandingOrder, error)
+	// Reprocessar a mesma ocorrência (após queda do scheduler) não duplica o histórico
+	CreateStandingOrderOccurrence(ctx context.Context, arg CreateStandingOrderOccurrenceParams) error
 	CreateTransaction(ctx context.Context, arg CreateTransactionParams) (Transaction, error)
-	CreateWallet(ctx context.Context, balance int64) (Wallet, error)
+	CreateWallet(ctx context.Context, arg CreateWalletParams) (Wallet, error)
+	CreateWalletLimitChange(ctx context.Context, arg CreateWalletLimitChangeParams) (WalletLimitChange, error)
 	// Segurança extra além do Check Constraint
 	CreditWallet(ctx context.Context, arg CreditWalletParams) error
 	// Retorna número de linhas afetadas. Se 0, ou saldo insuficiente ou ID errado.
+	// O saldo checado é o disponível: valores reservados por autorizações não podem ser gastos,
+	// e o limite de cheque especial permite que o saldo fique negativo até -overdraft_limit.
 	DebitWallet(ctx context.Context, arg DebitWalletParams) (int64, error)
+	DeleteCustomer(ctx context.Context, id int64) (int64, error)
+	DeleteFeePolicy(ctx context.Context, arg DeleteFeePolicyParams) (int64, error)
+	// Expurgo administrativo: o próximo envio da chave executa a operação de novo
+	DeleteIdempotencyKeys(ctx context.Context, arg DeleteIdempotencyKeysParams) ([]DeleteIdempotencyKeysRow, error)
+	// Grava o resultado da execução: executed, failed ou pending (nova tentativa em next_attempt_at).
+	// Só vale para a reserva feita por este scheduler: se o lease venceu e outra instância reservou
+	// de novo (attempts mudou) ou já gravou o resultado, nada é alterado.
+	FinishScheduledTransfer(ctx context.Context, arg FinishScheduledTransferParams) (int64, error)
+	// Grava o progresso do executor e libera a reserva.
+	// Se o cliente pausou ou cancelou durante a execução, o status dele prevalece.
+	FinishStandingOrderRun(ctx context.Context, arg FinishStandingOrderRunParams) error
+	GetCustomer(ctx context.Context, id int64) (Customer, error)
+	GetFXQuoteForUpdate(ctx context.Context, id pgtype.UUID) (FxQuote, error)
+	GetFeePolicy(ctx context.Context, arg GetFeePolicyParams) (FeePolicy, error)
+	GetHold(ctx context.Context, id pgtype.UUID) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id pgtype.UUID) (Hold, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetIdempotencyResponse(ctx context.Context, arg GetIdempotencyResponseParams) (IdempotencyKey, error)
+	GetInterestAccount(ctx context.Context, walletID int64) (InterestAccount, error)
+	GetInterestAccountForUpdate(ctx context.Context, walletID int64) (InterestAccount, error)
+	GetInterestPayout(ctx context.Context, arg GetInterestPayoutParams) (InterestPayout, error)
+	// Última fotografia fechada até o instante
+	GetLatestBalanceSnapshot(ctx context.Context, arg GetLatestBalanceSnapshotParams) (BalanceSnapshot, error)
+	GetLimitTier(ctx context.Context, code string) (LimitTier, error)
+	GetScheduledTransfer(ctx context.Context, id pgtype.UUID) (ScheduledTransfer, error)
+	GetStandingOrder(ctx context.Context, id pgtype.UUID) (StandingOrder, error)
+	GetSystemWallet(ctx context.Context, arg GetSystemWalletParams) (Wallet, error)
+	GetTransaction(ctx context.Context, id pgtype.UUID) (Transaction, error)
+	// A chave vale dentro do escopo (cliente e rota) que a enviou.
+	// O "IS NOT NULL" deixa explícito para o planner que pode usar o índice parcial idx_transactions_idem_key
+	GetTransactionByIdempotencyKey(ctx context.Context, arg GetTransactionByIdempotencyKeyParams) (Transaction, error)
+	// Trava a transação original: estornos concorrentes não podem ultrapassar o valor dela
+	GetTransactionForUpdate(ctx context.Context, id pgtype.UUID) (Transaction, error)
 	GetWallet(ctx context.Context, id int64) (Wallet, error)
+	// Saldo da carteira num instante: soma das partidas registradas antes dele
+	GetWalletBalanceAt(ctx context.Context, arg GetWalletBalanceAtParams) (int64, error)
 	// 🚨 CRÍTICO: "FOR UPDATE" trava a linha até o fim da transação
 	GetWalletForUpdate(ctx context.Context, id int64) (Wallet, error)
-	ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]Transaction, error)
+	// Saldo reconstruído a partir das partidas (fonte da verdade do ledger)
+	GetWalletLedgerBalance(ctx context.Context, walletID int64) (int64, error)
+	GetWalletLimits(ctx context.Context, walletID int64) (WalletLimit, error)
+	// Saídas da carteira em cada janela de limite: toda partida de débito (transferência, perna de lançamento, saque),
+	// sem a tarifa cobrada junto na transferência. Estornos devolvem dinheiro já transferido e não consomem limite.
+	GetWalletOutgoingUsage(ctx context.Context, arg GetWalletOutgoingUsageParams) (GetWalletOutgoingUsageRow, error)
+	// Reserva saldo disponível. Se 0 linhas, saldo disponível insuficiente ou ID errado.
+	HoldWalletFunds(ctx context.Context, arg HoldWalletFundsParams) (int64, error)
+	// Paginação por cursor (keyset) sobre o id
+	ListCustomers(ctx context.Context, arg ListCustomersParams) ([]Customer, error)
+	ListEntriesByTransaction(ctx context.Context, transactionID pgtype.UUID) ([]Entry, error)
+	ListExpiredHolds(ctx context.Context, arg ListExpiredHoldsParams) ([]pgtype.UUID, error)
+	ListFeePolicies(ctx context.Context) ([]FeePolicy, error)
+	// Consulta administrativa: todas as gravações de uma chave, opcionalmente de um cliente ou rota
+	ListIdempotencyKeys(ctx context.Context, arg ListIdempotencyKeysParams) ([]IdempotencyKey, error)
+	ListInterestAccountsForAccrual(ctx context.Context, accrualDate pgtype.Date) ([]InterestAccount, error)
+	ListInterestPayouts(ctx context.Context, arg ListInterestPayoutsParams) ([]InterestPayout, error)
+	ListLimitTiers(ctx context.Context) ([]LimitTier, error)
+	ListScheduledTransfersByWallet(ctx context.Context, arg ListScheduledTransfersByWalletParams) ([]ScheduledTransfer, error)
+	ListStandingOrderOccurrences(ctx context.Context, arg ListStandingOrderOccurrencesParams) ([]StandingOrderOccurrence, error)
+	ListStandingOrdersByWallet(ctx context.Context, arg ListStandingOrdersByWalletParams) ([]StandingOrder, error)
+	// Extrato da carteira com paginação por cursor (keyset) sobre entries.id.
+	// Cada linha é uma partida da carteira, com o valor assinado do ponto de vista dela.
+	ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]ListTransactionsRow, error)
+	// Movimento líquido por dia do calendário no fuso informado (só os dias com partidas)
+	ListWalletDailyNetAmounts(ctx context.Context, arg ListWalletDailyNetAmountsParams) ([]ListWalletDailyNetAmountsRow, error)
+	ListWalletLimitChanges(ctx context.Context, arg ListWalletLimitChangesParams) ([]WalletLimitChange, error)
+	// Partidas da carteira no período [since, until), da mais antiga para a mais recente (ordem do saldo corrido).
+	// LEFT JOIN: os saldos de abertura antigos (transaction_id NULL) também entram no extrato.
+	ListWalletStatement(ctx context.Context, arg ListWalletStatementParams) ([]ListWalletStatementRow, error)
+	ListWalletsByCustomer(ctx context.Context, customerID pgtype.Int8) ([]Wallet, error)
+	ListWalletsWithUnpaidInterest(ctx context.Context, until pgtype.Date) ([]int64, error)
+	MarkFXQuoteUsed(ctx context.Context, id pgtype.UUID) (int64, error)
+	MarkInterestAccrualsPaid(ctx context.Context, arg MarkInterestAccrualsPaidParams) error
+	// Só apaga a reserva se ainda for nossa e não virou resposta gravada
+	ReleaseIdempotencyKey(ctx context.Context, arg ReleaseIdempotencyKeyParams) error
+	ReleaseWalletFunds(ctx context.Context, arg ReleaseWalletFundsParams) (int64, error)
+	// Reserva a chave (SET NX): só toma a linha existente se a reserva anterior venceu (requisição caiu)
+	// ou se a resposta gravada expirou. Nenhuma linha = a chave está com outra requisição.
+	ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (string, error)
+	SaveIdempotencyResponse(ctx context.Context, arg SaveIdempotencyResponseParams) error
+	SumUnpaidInterestAccruals(ctx context.Context, arg SumUnpaidInterestAccrualsParams) (pgtype.Numeric, error)
+	// Movimento líquido da carteira no intervalo [since, until)
+	SumWalletEntriesBetween(ctx context.Context, arg SumWalletEntriesBetweenParams) (int64, error)
+	// O documento não muda: identifica o titular
+	UpdateCustomer(ctx context.Context, arg UpdateCustomerParams) (Customer, error)
+	UpdateInterestAccountCarry(ctx context.Context, arg UpdateInterestAccountCarryParams) error
+	// Alterações do cliente (valor, política, pausa/retomada, cancelamento)
+	UpdateStandingOrder(ctx context.Context, arg UpdateStandingOrderParams) error
+	UpdateTransactionRefund(ctx context.Context, arg UpdateTransactionRefundParams) error
 	UpdateWalletBalance(ctx context.Context, arg UpdateWalletBalanceParams) error
+	UpdateWalletOverdraftLimit(ctx context.Context, arg UpdateWalletOverdraftLimitParams) error
+	UpdateWalletStatus(ctx context.Context, arg UpdateWalletStatusParams) error
+	UpsertFeePolicy(ctx context.Context, arg UpsertFeePolicyParams) (FeePolicy, error)
+	// Reconfigurar a taxa não muda start_date nem a fração acumulada
+	UpsertInterestAccount(ctx context.Context, arg UpsertInterestAccountParams) (InterestAccount, error)
+	UpsertLimitTier(ctx context.Context, arg UpsertLimitTierParams) (LimitTier, error)
+	UpsertWalletLimits(ctx context.Context, arg UpsertWalletLimitsParams) (WalletLimit, error)
 }
 
 var _ Querier = (*Queries)(nil)
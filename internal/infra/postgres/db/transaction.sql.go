@@ -17,18 +17,38 @@ INSERT INTO transactions (
     to_wallet_id,
     amount,
     status,
-    idempotency_key
+    idempotency_key,
+    currency,
+    destination_amount,
+    destination_currency,
+    fx_rate,
+    fx_quote_id,
+    original_transaction_id,
+    kind,
+    description,
+    fee_amount,
+    idempotency_scope
 )
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, from_wallet_id, to_wallet_id, amount, status, idempotency_key, created_at
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING id, from_wallet_id, to_wallet_id, amount, status, idempotency_key, created_at, currency, destination_amount, destination_currency, fx_rate, fx_quote_id, refunded_amount, original_transaction_id, kind, description, fee_amount, idempotency_scope
 `
 
 type CreateTransactionParams struct {
-	FromWalletID   int64       `json:"from_wallet_id"`
-	ToWalletID     int64       `json:"to_wallet_id"`
-	Amount         int64       `json:"amount"`
-	Status         string      `json:"status"`
-	IdempotencyKey pgtype.Text `json:"idempotency_key"`
+	FromWalletID          pgtype.Int8    `json:"from_wallet_id"`
+	ToWalletID            pgtype.Int8    `json:"to_wallet_id"`
+	Amount                int64          `json:"amount"`
+	Status                string         `json:"status"`
+	IdempotencyKey        pgtype.Text    `json:"idempotency_key"`
+	Currency              string         `json:"currency"`
+	DestinationAmount     pgtype.Int8    `json:"destination_amount"`
+	DestinationCurrency   pgtype.Text    `json:"destination_currency"`
+	FxRate                pgtype.Numeric `json:"fx_rate"`
+	FxQuoteID             pgtype.UUID    `json:"fx_quote_id"`
+	OriginalTransactionID pgtype.UUID    `json:"original_transaction_id"`
+	Kind                  string         `json:"kind"`
+	Description           pgtype.Text    `json:"description"`
+	FeeAmount             int64          `json:"fee_amount"`
+	IdempotencyScope      string         `json:"idempotency_scope"`
 }
 
 func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) (Transaction, error) {
@@ -38,7 +58,88 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		arg.Amount,
 		arg.Status,
 		arg.IdempotencyKey,
+		arg.Currency,
+		arg.DestinationAmount,
+		arg.DestinationCurrency,
+		arg.FxRate,
+		arg.FxQuoteID,
+		arg.OriginalTransactionID,
+		arg.Kind,
+		arg.Description,
+		arg.FeeAmount,
+		arg.IdempotencyScope,
+	)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.FromWalletID,
+		&i.ToWalletID,
+		&i.Amount,
+		&i.Status,
+		&i.IdempotencyKey,
+		&i.CreatedAt,
+		&i.Currency,
+		&i.DestinationAmount,
+		&i.DestinationCurrency,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.RefundedAmount,
+		&i.OriginalTransactionID,
+		&i.Kind,
+		&i.Description,
+		&i.FeeAmount,
+		&i.IdempotencyScope,
+	)
+	return i, err
+}
+
+const getTransaction = `-- name: GetTransaction :one
+SELECT id, from_wallet_id, to_wallet_id, amount, status, idempotency_key, created_at, currency, destination_amount, destination_currency, fx_rate, fx_quote_id, refunded_amount, original_transaction_id, kind, description, fee_amount, idempotency_scope FROM transactions
+WHERE id = $1
+`
+
+func (q *Queries) GetTransaction(ctx context.Context, id pgtype.UUID) (Transaction, error) {
+	row := q.db.QueryRow(ctx, getTransaction, id)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.FromWalletID,
+		&i.ToWalletID,
+		&i.Amount,
+		&i.Status,
+		&i.IdempotencyKey,
+		&i.CreatedAt,
+		&i.Currency,
+		&i.DestinationAmount,
+		&i.DestinationCurrency,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.RefundedAmount,
+		&i.OriginalTransactionID,
+		&i.Kind,
+		&i.Description,
+		&i.FeeAmount,
+		&i.IdempotencyScope,
 	)
+	return i, err
+}
+
+const getTransactionByIdempotencyKey = `-- name: GetTransactionByIdempotencyKey :one
+SELECT id, from_wallet_id, to_wallet_id, amount, status, idempotency_key, created_at, currency, destination_amount, destination_currency, fx_rate, fx_quote_id, refunded_amount, original_transaction_id, kind, description, fee_amount, idempotency_scope FROM transactions
+WHERE idempotency_scope = $1
+  AND idempotency_key = $2
+  AND idempotency_key IS NOT NULL
+`
+
+type GetTransactionByIdempotencyKeyParams struct {
+	IdempotencyScope string      `json:"idempotency_scope"`
+	IdempotencyKey   pgtype.Text `json:"idempotency_key"`
+}
+
+// A chave vale dentro do escopo (cliente e rota) que a enviou.
+// O "IS NOT NULL" deixa explícito para o planner que pode usar o índice parcial idx_transactions_idem_key
+func (q *Queries) GetTransactionByIdempotencyKey(ctx context.Context, arg GetTransactionByIdempotencyKeyParams) (Transaction, error) {
+	row := q.db.QueryRow(ctx, getTransactionByIdempotencyKey, arg.IdempotencyScope, arg.IdempotencyKey)
 	var i Transaction
 	err := row.Scan(
 		&i.ID,
@@ -48,33 +149,135 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		&i.Status,
 		&i.IdempotencyKey,
 		&i.CreatedAt,
+		&i.Currency,
+		&i.DestinationAmount,
+		&i.DestinationCurrency,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.RefundedAmount,
+		&i.OriginalTransactionID,
+		&i.Kind,
+		&i.Description,
+		&i.FeeAmount,
+		&i.IdempotencyScope,
+	)
+	return i, err
+}
+
+const getTransactionForUpdate = `-- name: GetTransactionForUpdate :one
+SELECT id, from_wallet_id, to_wallet_id, amount, status, idempotency_key, created_at, currency, destination_amount, destination_currency, fx_rate, fx_quote_id, refunded_amount, original_transaction_id, kind, description, fee_amount, idempotency_scope FROM transactions
+WHERE id = $1
+FOR UPDATE
+`
+
+// Trava a transação original: estornos concorrentes não podem ultrapassar o valor dela
+func (q *Queries) GetTransactionForUpdate(ctx context.Context, id pgtype.UUID) (Transaction, error) {
+	row := q.db.QueryRow(ctx, getTransactionForUpdate, id)
+	var i Transaction
+	err := row.Scan(
+		&i.ID,
+		&i.FromWalletID,
+		&i.ToWalletID,
+		&i.Amount,
+		&i.Status,
+		&i.IdempotencyKey,
+		&i.CreatedAt,
+		&i.Currency,
+		&i.DestinationAmount,
+		&i.DestinationCurrency,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.RefundedAmount,
+		&i.OriginalTransactionID,
+		&i.Kind,
+		&i.Description,
+		&i.FeeAmount,
+		&i.IdempotencyScope,
 	)
 	return i, err
 }
 
 const listTransactions = `-- name: ListTransactions :many
-SELECT id, from_wallet_id, to_wallet_id, amount, status, idempotency_key, created_at FROM transactions
-WHERE from_wallet_id = $1 OR to_wallet_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+SELECT
+    e.id AS entry_id,
+    e.amount AS signed_amount,
+    t.id,
+    t.from_wallet_id,
+    t.to_wallet_id,
+    t.amount,
+    t.status,
+    t.idempotency_key,
+    t.created_at,
+    t.currency,
+    t.kind,
+    t.fee_amount
+FROM entries e
+JOIN transactions t ON t.id = e.transaction_id
+WHERE e.wallet_id = $1
+  AND ($2::bigint IS NULL OR e.id < $2)
+  AND ($3::text IS NULL
+       OR ($3 = 'in' AND e.amount > 0)
+       OR ($3 = 'out' AND e.amount < 0))
+  AND ($4::timestamptz IS NULL OR t.created_at >= $4)
+  AND ($5::timestamptz IS NULL OR t.created_at < $5)
+  AND ($6::bigint IS NULL OR t.amount >= $6)
+  AND ($7::bigint IS NULL OR t.amount <= $7)
+  AND ($8::text IS NULL OR t.status = $8)
+ORDER BY e.id DESC
+LIMIT $9
 `
 
 type ListTransactionsParams struct {
-	FromWalletID int64 `json:"from_wallet_id"`
-	Limit        int32 `json:"limit"`
-	Offset       int32 `json:"offset"`
+	WalletID  int64              `json:"wallet_id"`
+	Cursor    pgtype.Int8        `json:"cursor"`
+	Direction pgtype.Text        `json:"direction"`
+	FromDate  pgtype.Timestamptz `json:"from_date"`
+	ToDate    pgtype.Timestamptz `json:"to_date"`
+	MinAmount pgtype.Int8        `json:"min_amount"`
+	MaxAmount pgtype.Int8        `json:"max_amount"`
+	Status    pgtype.Text        `json:"status"`
+	PageSize  int32              `json:"page_size"`
+}
+
+type ListTransactionsRow struct {
+	EntryID        int64              `json:"entry_id"`
+	SignedAmount   int64              `json:"signed_amount"`
+	ID             pgtype.UUID        `json:"id"`
+	FromWalletID   pgtype.Int8        `json:"from_wallet_id"`
+	ToWalletID     pgtype.Int8        `json:"to_wallet_id"`
+	Amount         int64              `json:"amount"`
+	Status         string             `json:"status"`
+	IdempotencyKey pgtype.Text        `json:"idempotency_key"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	Currency       string             `json:"currency"`
+	Kind           string             `json:"kind"`
+	FeeAmount      int64              `json:"fee_amount"`
 }
 
-func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]Transaction, error) {
-	rows, err := q.db.Query(ctx, listTransactions, arg.FromWalletID, arg.Limit, arg.Offset)
+// Extrato da carteira com paginação por cursor (keyset) sobre entries.id.
+// Cada linha é uma partida da carteira, com o valor assinado do ponto de vista dela.
+func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsParams) ([]ListTransactionsRow, error) {
+	rows, err := q.db.Query(ctx, listTransactions,
+		arg.WalletID,
+		arg.Cursor,
+		arg.Direction,
+		arg.FromDate,
+		arg.ToDate,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Status,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Transaction
+	var items []ListTransactionsRow
 	for rows.Next() {
-		var i Transaction
+		var i ListTransactionsRow
 		if err := rows.Scan(
+			&i.EntryID,
+			&i.SignedAmount,
 			&i.ID,
 			&i.FromWalletID,
 			&i.ToWalletID,
@@ -82,6 +285,9 @@ func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsPara
 			&i.Status,
 			&i.IdempotencyKey,
 			&i.CreatedAt,
+			&i.Currency,
+			&i.Kind,
+			&i.FeeAmount,
 		); err != nil {
 			return nil, err
 		}
@@ -92,3 +298,99 @@ func (q *Queries) ListTransactions(ctx context.Context, arg ListTransactionsPara
 	}
 	return items, nil
 }
+
+const listWalletStatement = `-- name: ListWalletStatement :many
+SELECT
+    e.id AS entry_id,
+    e.amount AS signed_amount,
+    e.currency,
+    e.created_at,
+    t.id,
+    t.kind,
+    t.from_wallet_id,
+    t.to_wallet_id,
+    t.status,
+    t.description,
+    t.fee_amount,
+    t.original_transaction_id
+FROM entries e
+LEFT JOIN transactions t ON t.id = e.transaction_id
+WHERE e.wallet_id = $1
+  AND e.created_at >= $2
+  AND e.created_at < $3
+ORDER BY e.created_at, e.id
+`
+
+type ListWalletStatementParams struct {
+	WalletID int64              `json:"wallet_id"`
+	Since    pgtype.Timestamptz `json:"since"`
+	Until    pgtype.Timestamptz `json:"until"`
+}
+
+type ListWalletStatementRow struct {
+	EntryID               int64              `json:"entry_id"`
+	SignedAmount          int64              `json:"signed_amount"`
+	Currency              string             `json:"currency"`
+	CreatedAt             pgtype.Timestamptz `json:"created_at"`
+	ID                    pgtype.UUID        `json:"id"`
+	Kind                  pgtype.Text        `json:"kind"`
+	FromWalletID          pgtype.Int8        `json:"from_wallet_id"`
+	ToWalletID            pgtype.Int8        `json:"to_wallet_id"`
+	Status                pgtype.Text        `json:"status"`
+	Description           pgtype.Text        `json:"description"`
+	FeeAmount             pgtype.Int8        `json:"fee_amount"`
+	OriginalTransactionID pgtype.UUID        `json:"original_transaction_id"`
+}
+
+// Partidas da carteira no período [since, until), da mais antiga para a mais recente (ordem do saldo corrido).
+// LEFT JOIN: os saldos de abertura antigos (transaction_id NULL) também entram no extrato.
+func (q *Queries) ListWalletStatement(ctx context.Context, arg ListWalletStatementParams) ([]ListWalletStatementRow, error) {
+	rows, err := q.db.Query(ctx, listWalletStatement, arg.WalletID, arg.Since, arg.Until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWalletStatementRow
+	for rows.Next() {
+		var i ListWalletStatementRow
+		if err := rows.Scan(
+			&i.EntryID,
+			&i.SignedAmount,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.ID,
+			&i.Kind,
+			&i.FromWalletID,
+			&i.ToWalletID,
+			&i.Status,
+			&i.Description,
+			&i.FeeAmount,
+			&i.OriginalTransactionID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateTransactionRefund = `-- name: UpdateTransactionRefund :exec
+UPDATE transactions
+SET refunded_amount = $2,
+    status = $3
+WHERE id = $1
+`
+
+type UpdateTransactionRefundParams struct {
+	ID             pgtype.UUID `json:"id"`
+	RefundedAmount int64       `json:"refunded_amount"`
+	Status         string      `json:"status"`
+}
+
+func (q *Queries) UpdateTransactionRefund(ctx context.Context, arg UpdateTransactionRefundParams) error {
+	_, err := q.db.Exec(ctx, updateTransactionRefund, arg.ID, arg.RefundedAmount, arg.Status)
+	return err
+}
@@ -7,16 +7,24 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createWallet = `-- name: CreateWallet :one
-INSERT INTO wallets (balance)
-VALUES ($1)
-RETURNING id, balance, version, created_at, updated_at
+INSERT INTO wallets (balance, currency, customer_id)
+VALUES ($1, $2, $3)
+RETURNING id, balance, version, created_at, updated_at, currency, system_code, held_amount, overdraft_limit, status, customer_id
 `
 
-func (q *Queries) CreateWallet(ctx context.Context, balance int64) (Wallet, error) {
-	row := q.db.QueryRow(ctx, createWallet, balance)
+type CreateWalletParams struct {
+	Balance    int64       `json:"balance"`
+	Currency   string      `json:"currency"`
+	CustomerID pgtype.Int8 `json:"customer_id"`
+}
+
+func (q *Queries) CreateWallet(ctx context.Context, arg CreateWalletParams) (Wallet, error) {
+	row := q.db.QueryRow(ctx, createWallet, arg.Balance, arg.Currency, arg.CustomerID)
 	var i Wallet
 	err := row.Scan(
 		&i.ID,
@@ -24,6 +32,47 @@ func (q *Queries) CreateWallet(ctx context.Context, balance int64) (Wallet, erro
 		&i.Version,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Currency,
+		&i.SystemCode,
+		&i.HeldAmount,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.CustomerID,
+	)
+	return i, err
+}
+
+const createWalletLimitChange = `-- name: CreateWalletLimitChange :one
+INSERT INTO wallet_limit_changes (wallet_id, previous_limit, new_limit, changed_by, reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, wallet_id, previous_limit, new_limit, changed_by, reason, created_at
+`
+
+type CreateWalletLimitChangeParams struct {
+	WalletID      int64  `json:"wallet_id"`
+	PreviousLimit int64  `json:"previous_limit"`
+	NewLimit      int64  `json:"new_limit"`
+	ChangedBy     string `json:"changed_by"`
+	Reason        string `json:"reason"`
+}
+
+func (q *Queries) CreateWalletLimitChange(ctx context.Context, arg CreateWalletLimitChangeParams) (WalletLimitChange, error) {
+	row := q.db.QueryRow(ctx, createWalletLimitChange,
+		arg.WalletID,
+		arg.PreviousLimit,
+		arg.NewLimit,
+		arg.ChangedBy,
+		arg.Reason,
+	)
+	var i WalletLimitChange
+	err := row.Scan(
+		&i.ID,
+		&i.WalletID,
+		&i.PreviousLimit,
+		&i.NewLimit,
+		&i.ChangedBy,
+		&i.Reason,
+		&i.CreatedAt,
 	)
 	return i, err
 }
@@ -54,7 +103,7 @@ SET balance = balance - $1,
     version = version + 1,
     updated_at = NOW()
 WHERE id = $2
-  AND balance >= $1
+  AND (system_code IS NOT NULL OR balance - held_amount + overdraft_limit >= $1)
 `
 
 type DebitWalletParams struct {
@@ -63,6 +112,8 @@ type DebitWalletParams struct {
 }
 
 // Retorna número de linhas afetadas. Se 0, ou saldo insuficiente ou ID errado.
+// O saldo checado é o disponível: valores reservados por autorizações não podem ser gastos,
+// e o limite de cheque especial permite que o saldo fique negativo até -overdraft_limit.
 func (q *Queries) DebitWallet(ctx context.Context, arg DebitWalletParams) (int64, error) {
 	result, err := q.db.Exec(ctx, debitWallet, arg.Amount, arg.ID)
 	if err != nil {
@@ -71,8 +122,38 @@ func (q *Queries) DebitWallet(ctx context.Context, arg DebitWalletParams) (int64
 	return result.RowsAffected(), nil
 }
 
+const getSystemWallet = `-- name: GetSystemWallet :one
+SELECT id, balance, version, created_at, updated_at, currency, system_code, held_amount, overdraft_limit, status, customer_id FROM wallets
+WHERE system_code = $1
+  AND currency = $2
+`
+
+type GetSystemWalletParams struct {
+	SystemCode pgtype.Text `json:"system_code"`
+	Currency   string      `json:"currency"`
+}
+
+func (q *Queries) GetSystemWallet(ctx context.Context, arg GetSystemWalletParams) (Wallet, error) {
+	row := q.db.QueryRow(ctx, getSystemWallet, arg.SystemCode, arg.Currency)
+	var i Wallet
+	err := row.Scan(
+		&i.ID,
+		&i.Balance,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Currency,
+		&i.SystemCode,
+		&i.HeldAmount,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.CustomerID,
+	)
+	return i, err
+}
+
 const getWallet = `-- name: GetWallet :one
-SELECT id, balance, version, created_at, updated_at FROM wallets
+SELECT id, balance, version, created_at, updated_at, currency, system_code, held_amount, overdraft_limit, status, customer_id FROM wallets
 WHERE id = $1
 `
 
@@ -85,12 +166,18 @@ func (q *Queries) GetWallet(ctx context.Context, id int64) (Wallet, error) {
 		&i.Version,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Currency,
+		&i.SystemCode,
+		&i.HeldAmount,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.CustomerID,
 	)
 	return i, err
 }
 
 const getWalletForUpdate = `-- name: GetWalletForUpdate :one
-SELECT id, balance, version, created_at, updated_at FROM wallets
+SELECT id, balance, version, created_at, updated_at, currency, system_code, held_amount, overdraft_limit, status, customer_id FROM wallets
 WHERE id = $1
 FOR UPDATE
 `
@@ -105,10 +192,139 @@ func (q *Queries) GetWalletForUpdate(ctx context.Context, id int64) (Wallet, err
 		&i.Version,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Currency,
+		&i.SystemCode,
+		&i.HeldAmount,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.CustomerID,
 	)
 	return i, err
 }
 
+const holdWalletFunds = `-- name: HoldWalletFunds :execrows
+UPDATE wallets
+SET held_amount = held_amount + $1,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $2
+  AND balance - held_amount + overdraft_limit >= $1
+`
+
+type HoldWalletFundsParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+// Reserva saldo disponível. Se 0 linhas, saldo disponível insuficiente ou ID errado.
+func (q *Queries) HoldWalletFunds(ctx context.Context, arg HoldWalletFundsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, holdWalletFunds, arg.Amount, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const listWalletLimitChanges = `-- name: ListWalletLimitChanges :many
+SELECT id, wallet_id, previous_limit, new_limit, changed_by, reason, created_at FROM wallet_limit_changes
+WHERE wallet_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListWalletLimitChangesParams struct {
+	WalletID int64 `json:"wallet_id"`
+	PageSize int32 `json:"page_size"`
+}
+
+func (q *Queries) ListWalletLimitChanges(ctx context.Context, arg ListWalletLimitChangesParams) ([]WalletLimitChange, error) {
+	rows, err := q.db.Query(ctx, listWalletLimitChanges, arg.WalletID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WalletLimitChange
+	for rows.Next() {
+		var i WalletLimitChange
+		if err := rows.Scan(
+			&i.ID,
+			&i.WalletID,
+			&i.PreviousLimit,
+			&i.NewLimit,
+			&i.ChangedBy,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWalletsByCustomer = `-- name: ListWalletsByCustomer :many
+SELECT id, balance, version, created_at, updated_at, currency, system_code, held_amount, overdraft_limit, status, customer_id FROM wallets
+WHERE customer_id = $1
+ORDER BY id
+`
+
+func (q *Queries) ListWalletsByCustomer(ctx context.Context, customerID pgtype.Int8) ([]Wallet, error) {
+	rows, err := q.db.Query(ctx, listWalletsByCustomer, customerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Wallet
+	for rows.Next() {
+		var i Wallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.Balance,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Currency,
+			&i.SystemCode,
+			&i.HeldAmount,
+			&i.OverdraftLimit,
+			&i.Status,
+			&i.CustomerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseWalletFunds = `-- name: ReleaseWalletFunds :execrows
+UPDATE wallets
+SET held_amount = held_amount - $1,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $2
+  AND held_amount >= $1
+`
+
+type ReleaseWalletFundsParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) ReleaseWalletFunds(ctx context.Context, arg ReleaseWalletFundsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, releaseWalletFunds, arg.Amount, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateWalletBalance = `-- name: UpdateWalletBalance :exec
 UPDATE wallets
 SET balance = $2,
@@ -126,3 +342,39 @@ func (q *Queries) UpdateWalletBalance(ctx context.Context, arg UpdateWalletBalan
 	_, err := q.db.Exec(ctx, updateWalletBalance, arg.ID, arg.Balance)
 	return err
 }
+
+const updateWalletOverdraftLimit = `-- name: UpdateWalletOverdraftLimit :exec
+UPDATE wallets
+SET overdraft_limit = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateWalletOverdraftLimitParams struct {
+	ID             int64 `json:"id"`
+	OverdraftLimit int64 `json:"overdraft_limit"`
+}
+
+func (q *Queries) UpdateWalletOverdraftLimit(ctx context.Context, arg UpdateWalletOverdraftLimitParams) error {
+	_, err := q.db.Exec(ctx, updateWalletOverdraftLimit, arg.ID, arg.OverdraftLimit)
+	return err
+}
+
+const updateWalletStatus = `-- name: UpdateWalletStatus :exec
+UPDATE wallets
+SET status = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateWalletStatusParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) UpdateWalletStatus(ctx context.Context, arg UpdateWalletStatusParams) error {
+	_, err := q.db.Exec(ctx, updateWalletStatus, arg.ID, arg.Status)
+	return err
+}
@@ -0,0 +1,155 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// EntryRepository implementa gateway.EntryRepository usando pgx/v5
+type EntryRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewEntryRepository(pool *pgxpool.Pool) *EntryRepository {
+	return &EntryRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *EntryRepository) Create(ctx context.Context, entry *domain.Entry) error {
+	transactionID, err := uuidToPgType(entry.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.queries.CreateEntry(ctx, db.CreateEntryParams{
+		TransactionID: transactionID,
+		WalletID:      entry.WalletID,
+		Amount:        entry.Amount,
+		Currency:      string(entry.Currency),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create entry: %w", err)
+	}
+
+	entry.ID = row.ID
+	entry.CreatedAt = row.CreatedAt.Time
+	return nil
+}
+
+func (r *EntryRepository) ListByTransaction(ctx context.Context, transactionID string) ([]domain.Entry, error) {
+	id, err := uuidToPgType(transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.queries.ListEntriesByTransaction(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entries: %w", err)
+	}
+
+	entries := make([]domain.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, toDomainEntry(row))
+	}
+	return entries, nil
+}
+
+func (r *EntryRepository) LedgerBalance(ctx context.Context, walletID int64) (int64, error) {
+	balance, err := r.queries.GetWalletLedgerBalance(ctx, walletID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum wallet entries: %w", err)
+	}
+	return balance, nil
+}
+
+func (r *EntryRepository) BalanceAt(ctx context.Context, walletID int64, at time.Time) (int64, error) {
+	balance, err := r.queries.GetWalletBalanceAt(ctx, db.GetWalletBalanceAtParams{
+		WalletID: walletID,
+		At:       pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum wallet entries: %w", err)
+	}
+	return balance, nil
+}
+
+func (r *EntryRepository) SumBetween(ctx context.Context, walletID int64, since, until time.Time) (int64, error) {
+	amount, err := r.queries.SumWalletEntriesBetween(ctx, db.SumWalletEntriesBetweenParams{
+		WalletID: walletID,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum wallet entries: %w", err)
+	}
+	return amount, nil
+}
+
+func (r *EntryRepository) DailyNetAmounts(ctx context.Context, walletID int64, since, until time.Time) ([]domain.DailyNetAmount, error) {
+	rows, err := r.queries.ListWalletDailyNetAmounts(ctx, db.ListWalletDailyNetAmountsParams{
+		TimeZone: domain.BusinessLocation.String(),
+		WalletID: walletID,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum wallet entries by day: %w", err)
+	}
+
+	amounts := make([]domain.DailyNetAmount, 0, len(rows))
+	for _, row := range rows {
+		amounts = append(amounts, domain.DailyNetAmount{
+			Date:   pgTypeToDate(row.Day),
+			Amount: row.Amount,
+		})
+	}
+	return amounts, nil
+}
+
+func (r *EntryRepository) WithTx(tx gateway.TransactionObject) gateway.EntryRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &EntryRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainEntry(e db.Entry) domain.Entry {
+	entry := domain.Entry{
+		ID:        e.ID,
+		WalletID:  e.WalletID,
+		Amount:    e.Amount,
+		Currency:  domain.Currency(e.Currency),
+		CreatedAt: e.CreatedAt.Time,
+	}
+	if e.TransactionID.Valid {
+		entry.TransactionID = e.TransactionID.String()
+	}
+	return entry
+}
+
+// Helper para converter string -> pgtype.UUID (string vazia vira NULL)
+func uuidToPgType(s string) (pgtype.UUID, error) {
+	var id pgtype.UUID
+	if s == "" {
+		return id, nil
+	}
+	if err := id.Scan(s); err != nil {
+		return id, fmt.Errorf("invalid uuid %q: %w", s, err)
+	}
+	return id, nil
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FeePolicyRepository implementa gateway.FeePolicyRepository usando pgx/v5
+type FeePolicyRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewFeePolicyRepository(pool *pgxpool.Pool) *FeePolicyRepository {
+	return &FeePolicyRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *FeePolicyRepository) Get(ctx context.Context, tier string, currency domain.Currency) (*domain.FeePolicy, error) {
+	row, err := r.queries.GetFeePolicy(ctx, db.GetFeePolicyParams{
+		TierCode: tier,
+		Currency: string(currency),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrFeePolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get fee policy: %w", err)
+	}
+	return toDomainFeePolicy(row)
+}
+
+func (r *FeePolicyRepository) List(ctx context.Context) ([]domain.FeePolicy, error) {
+	rows, err := r.queries.ListFeePolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list fee policies: %w", err)
+	}
+
+	policies := make([]domain.FeePolicy, 0, len(rows))
+	for _, row := range rows {
+		policy, err := toDomainFeePolicy(row)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, *policy)
+	}
+	return policies, nil
+}
+
+func (r *FeePolicyRepository) Save(ctx context.Context, policy *domain.FeePolicy) error {
+	brackets := policy.Brackets
+	if brackets == nil {
+		brackets = []domain.FeeBracket{}
+	}
+	encoded, err := json.Marshal(brackets)
+	if err != nil {
+		return fmt.Errorf("failed to encode fee brackets: %w", err)
+	}
+
+	row, err := r.queries.UpsertFeePolicy(ctx, db.UpsertFeePolicyParams{
+		TierCode:        policy.Tier,
+		Currency:        string(policy.Currency),
+		Kind:            policy.Kind,
+		FlatAmount:      policy.FlatAmount,
+		PercentageBps:   policy.PercentageBps,
+		MinAmount:       int8ToPgType(policy.MinAmount),
+		MaxAmount:       int8ToPgType(policy.MaxAmount),
+		Brackets:        encoded,
+		RevenueWalletID: int8ToPgType(policy.RevenueWalletID),
+	})
+	if err != nil {
+		// Faixa ou carteira de receita inexistente (FK)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			if pgErr.ConstraintName == "fee_policies_tier_code_fkey" {
+				return domain.ErrLimitTierNotFound
+			}
+			return domain.ErrWalletNotFound
+		}
+		return fmt.Errorf("failed to save fee policy: %w", err)
+	}
+
+	saved, err := toDomainFeePolicy(row)
+	if err != nil {
+		return err
+	}
+	*policy = *saved
+	return nil
+}
+
+func (r *FeePolicyRepository) Delete(ctx context.Context, tier string, currency domain.Currency) error {
+	rows, err := r.queries.DeleteFeePolicy(ctx, db.DeleteFeePolicyParams{
+		TierCode: tier,
+		Currency: string(currency),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete fee policy: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrFeePolicyNotFound
+	}
+	return nil
+}
+
+func (r *FeePolicyRepository) WithTx(tx gateway.TransactionObject) gateway.FeePolicyRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &FeePolicyRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainFeePolicy(p db.FeePolicy) (*domain.FeePolicy, error) {
+	var brackets []domain.FeeBracket
+	if len(p.Brackets) > 0 {
+		if err := json.Unmarshal(p.Brackets, &brackets); err != nil {
+			return nil, fmt.Errorf("failed to decode fee brackets: %w", err)
+		}
+	}
+	return &domain.FeePolicy{
+		ID:              p.ID,
+		Tier:            p.TierCode,
+		Currency:        domain.Currency(p.Currency),
+		Kind:            p.Kind,
+		FlatAmount:      p.FlatAmount,
+		PercentageBps:   p.PercentageBps,
+		MinAmount:       pgTypeToInt8(p.MinAmount),
+		MaxAmount:       pgTypeToInt8(p.MaxAmount),
+		Brackets:        brackets,
+		RevenueWalletID: pgTypeToInt8(p.RevenueWalletID),
+		CreatedAt:       p.CreatedAt.Time,
+		UpdatedAt:       p.UpdatedAt.Time,
+	}, nil
+}
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// FXQuoteRepository implementa gateway.FXQuoteRepository usando pgx/v5
+type FXQuoteRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewFXQuoteRepository(pool *pgxpool.Pool) *FXQuoteRepository {
+	return &FXQuoteRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *FXQuoteRepository) Create(ctx context.Context, quote *domain.FXQuote) error {
+	rate, err := ratToPgType(quote.Rate)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.queries.CreateFXQuote(ctx, db.CreateFXQuoteParams{
+		SourceCurrency:      string(quote.SourceCurrency),
+		DestinationCurrency: string(quote.DestinationCurrency),
+		Rate:                rate,
+		SourceAmount:        quote.SourceAmount,
+		DestinationAmount:   quote.DestinationAmount,
+		ExpiresAt:           pgtype.Timestamptz{Time: quote.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create fx quote: %w", err)
+	}
+
+	quote.ID = row.ID.String()
+	quote.CreatedAt = row.CreatedAt.Time
+	return nil
+}
+
+func (r *FXQuoteRepository) GetByIDForUpdate(ctx context.Context, id string) (*domain.FXQuote, error) {
+	quoteID, err := uuidToPgType(id)
+	if err != nil || !quoteID.Valid {
+		return nil, domain.ErrQuoteNotFound
+	}
+
+	row, err := r.queries.GetFXQuoteForUpdate(ctx, quoteID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrQuoteNotFound
+		}
+		return nil, fmt.Errorf("failed to lock fx quote: %w", err)
+	}
+	return toDomainFXQuote(row)
+}
+
+func (r *FXQuoteRepository) MarkUsed(ctx context.Context, id string) error {
+	quoteID, err := uuidToPgType(id)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := r.queries.MarkFXQuoteUsed(ctx, quoteID)
+	if err != nil {
+		return fmt.Errorf("failed to mark fx quote as used: %w", err)
+	}
+	// Se 0 linhas foram afetadas, a cláusula "AND used_at IS NULL" falhou
+	if rowsAffected == 0 {
+		return domain.ErrQuoteAlreadyUsed
+	}
+	return nil
+}
+
+func (r *FXQuoteRepository) WithTx(tx gateway.TransactionObject) gateway.FXQuoteRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &FXQuoteRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainFXQuote(q db.FxQuote) (*domain.FXQuote, error) {
+	rate, err := pgTypeToRat(q.Rate)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &domain.FXQuote{
+		ID:                  q.ID.String(),
+		SourceCurrency:      domain.Currency(q.SourceCurrency),
+		DestinationCurrency: domain.Currency(q.DestinationCurrency),
+		Rate:                rate,
+		SourceAmount:        q.SourceAmount,
+		DestinationAmount:   q.DestinationAmount,
+		ExpiresAt:           q.ExpiresAt.Time,
+		CreatedAt:           q.CreatedAt.Time,
+	}
+	if q.UsedAt.Valid {
+		quote.UsedAt = &q.UsedAt.Time
+	}
+	return quote, nil
+}
+
+// Helper para converter *big.Rat -> pgtype.Numeric (nil vira NULL)
+func ratToPgType(r *big.Rat) (pgtype.Numeric, error) {
+	var n pgtype.Numeric
+	if r == nil {
+		return n, nil
+	}
+	if err := n.Scan(r.FloatString(domain.RatePrecision)); err != nil {
+		return n, fmt.Errorf("invalid numeric %s: %w", r.String(), err)
+	}
+	return n, nil
+}
+
+// Helper para converter pgtype.Numeric -> *big.Rat (NULL vira nil)
+func pgTypeToRat(n pgtype.Numeric) (*big.Rat, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	value, err := n.Value()
+	if err != nil {
+		return nil, fmt.Errorf("invalid numeric: %w", err)
+	}
+	return domain.ParseRate(value.(string))
+}
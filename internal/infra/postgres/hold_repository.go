@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// HoldRepository implementa gateway.HoldRepository usando pgx/v5
+type HoldRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewHoldRepository(pool *pgxpool.Pool) *HoldRepository {
+	return &HoldRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *HoldRepository) Create(ctx context.Context, hold *domain.Hold) error {
+	row, err := r.queries.CreateHold(ctx, db.CreateHoldParams{
+		WalletID:   hold.WalletID,
+		ToWalletID: hold.ToWalletID,
+		Amount:     hold.Amount,
+		Currency:   string(hold.Currency),
+		ExpiresAt:  pgtype.Timestamptz{Time: hold.ExpiresAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create hold: %w", err)
+	}
+
+	*hold = *toDomainHold(row)
+	return nil
+}
+
+func (r *HoldRepository) GetByID(ctx context.Context, id string) (*domain.Hold, error) {
+	holdID, err := uuidToPgType(id)
+	if err != nil || !holdID.Valid {
+		return nil, domain.ErrHoldNotFound
+	}
+
+	row, err := r.queries.GetHold(ctx, holdID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("failed to get hold: %w", err)
+	}
+	return toDomainHold(row), nil
+}
+
+func (r *HoldRepository) GetByIDForUpdate(ctx context.Context, id string) (*domain.Hold, error) {
+	holdID, err := uuidToPgType(id)
+	if err != nil || !holdID.Valid {
+		return nil, domain.ErrHoldNotFound
+	}
+
+	row, err := r.queries.GetHoldForUpdate(ctx, holdID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrHoldNotFound
+		}
+		return nil, fmt.Errorf("failed to lock hold: %w", err)
+	}
+	return toDomainHold(row), nil
+}
+
+func (r *HoldRepository) Close(ctx context.Context, hold *domain.Hold) error {
+	holdID, err := uuidToPgType(hold.ID)
+	if err != nil {
+		return err
+	}
+	transactionID, err := uuidToPgType(hold.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := r.queries.CloseHold(ctx, db.CloseHoldParams{
+		Status:         hold.Status,
+		CapturedAmount: hold.CapturedAmount,
+		TransactionID:  transactionID,
+		ID:             holdID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close hold: %w", err)
+	}
+	// Se 0 linhas foram afetadas, a cláusula "AND status = 'active'" falhou
+	if rowsAffected == 0 {
+		return domain.ErrHoldNotActive
+	}
+	return nil
+}
+
+func (r *HoldRepository) ListExpired(ctx context.Context, now time.Time, limit int32) ([]string, error) {
+	rows, err := r.queries.ListExpiredHolds(ctx, db.ListExpiredHoldsParams{
+		ExpiresAt: pgtype.Timestamptz{Time: now, Valid: true},
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired holds: %w", err)
+	}
+
+	ids := make([]string, 0, len(rows))
+	for _, id := range rows {
+		ids = append(ids, id.String())
+	}
+	return ids, nil
+}
+
+func (r *HoldRepository) WithTx(tx gateway.TransactionObject) gateway.HoldRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &HoldRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainHold(h db.Hold) *domain.Hold {
+	hold := &domain.Hold{
+		ID:             h.ID.String(),
+		WalletID:       h.WalletID,
+		ToWalletID:     h.ToWalletID,
+		Amount:         h.Amount,
+		CapturedAmount: h.CapturedAmount,
+		Currency:       domain.Currency(h.Currency),
+		Status:         h.Status,
+		ExpiresAt:      h.ExpiresAt.Time,
+		CreatedAt:      h.CreatedAt.Time,
+		UpdatedAt:      h.UpdatedAt.Time,
+	}
+	if h.TransactionID.Valid {
+		hold.TransactionID = h.TransactionID.String()
+	}
+	return hold
+}
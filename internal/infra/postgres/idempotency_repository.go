@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// IdempotencyRepository implementa gateway.IdempotencyRepository e gateway.IdempotencyAdminRepository usando pgx/v5.
+// Com WithTx, a resposta é gravada na mesma transação da operação (durável junto com ela).
+type IdempotencyRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewIdempotencyRepository(pool *pgxpool.Pool) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, key gateway.IdempotencyKey) (*gateway.CachedResponse, error) {
+	row, err := r.queries.GetIdempotencyResponse(ctx, db.GetIdempotencyResponseParams{
+		ClientID: key.ClientID,
+		Route:    key.Route,
+		Key:      key.Key,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil // Não encontrado (ou ainda em processamento)
+		}
+		return nil, fmt.Errorf("failed to get idempotency response: %w", err)
+	}
+	return toCachedResponse(row)
+}
+
+func (r *IdempotencyRepository) Save(ctx context.Context, key gateway.IdempotencyKey, response gateway.CachedResponse, ttl time.Duration) error {
+	var headers []byte
+	if response.Headers != nil {
+		var err error
+		if headers, err = json.Marshal(response.Headers); err != nil {
+			return fmt.Errorf("failed to marshal idempotency headers: %w", err)
+		}
+	}
+
+	err := r.queries.SaveIdempotencyResponse(ctx, db.SaveIdempotencyResponseParams{
+		ClientID:    key.ClientID,
+		Route:       key.Route,
+		Key:         key.Key,
+		Fingerprint: response.Fingerprint,
+		StatusCode:  pgtype.Int4{Int32: int32(response.StatusCode), Valid: true},
+		Body:        response.Body,
+		Headers:     headers,
+		Ttl:         durationToPgType(ttl),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save idempotency response: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) Reserve(ctx context.Context, key gateway.IdempotencyKey, lock gateway.IdempotencyLock, lease time.Duration) (bool, *gateway.IdempotencyLock, error) {
+	_, err := r.queries.ReserveIdempotencyKey(ctx, db.ReserveIdempotencyKeyParams{
+		ClientID:    key.ClientID,
+		Route:       key.Route,
+		Key:         key.Key,
+		Fingerprint: lock.Fingerprint,
+		LockToken:   pgtype.Text{String: lock.Token, Valid: true},
+		Lease:       durationToPgType(lease),
+	})
+	if err == nil {
+		return true, nil, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return false, nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+
+	// A chave está com outra requisição (em processamento ou já respondida)
+	row, err := r.queries.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{
+		ClientID: key.ClientID,
+		Route:    key.Route,
+		Key:      key.Key,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil, nil // Foi liberada entre o INSERT e o SELECT
+		}
+		return false, nil, fmt.Errorf("failed to get idempotency reservation: %w", err)
+	}
+	return false, &gateway.IdempotencyLock{Token: row.LockToken.String, Fingerprint: row.Fingerprint}, nil
+}
+
+func (r *IdempotencyRepository) Release(ctx context.Context, key gateway.IdempotencyKey, lock gateway.IdempotencyLock) error {
+	err := r.queries.ReleaseIdempotencyKey(ctx, db.ReleaseIdempotencyKeyParams{
+		ClientID:  key.ClientID,
+		Route:     key.Route,
+		Key:       key.Key,
+		LockToken: pgtype.Text{String: lock.Token, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyRepository) List(ctx context.Context, filter gateway.IdempotencyFilter) ([]gateway.IdempotencyRecord, error) {
+	rows, err := r.queries.ListIdempotencyKeys(ctx, db.ListIdempotencyKeysParams{
+		Key:      filter.Key,
+		ClientID: filterToPgType(filter.ClientID),
+		Route:    filterToPgType(filter.Route),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list idempotency keys: %w", err)
+	}
+
+	records := make([]gateway.IdempotencyRecord, 0, len(rows))
+	for _, row := range rows {
+		record, err := toIdempotencyRecord(row)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+	return records, nil
+}
+
+func (r *IdempotencyRepository) Purge(ctx context.Context, filter gateway.IdempotencyFilter) ([]gateway.IdempotencyKey, error) {
+	rows, err := r.queries.DeleteIdempotencyKeys(ctx, db.DeleteIdempotencyKeysParams{
+		Key:      filter.Key,
+		ClientID: filterToPgType(filter.ClientID),
+		Route:    filterToPgType(filter.Route),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to purge idempotency keys: %w", err)
+	}
+
+	keys := make([]gateway.IdempotencyKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, gateway.IdempotencyKey{ClientID: row.ClientID, Route: row.Route, Key: row.Key})
+	}
+	return keys, nil
+}
+
+func (r *IdempotencyRepository) WithTx(tx gateway.TransactionObject) gateway.IdempotencyRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &IdempotencyRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toCachedResponse(row db.IdempotencyKey) (*gateway.CachedResponse, error) {
+	response := &gateway.CachedResponse{
+		StatusCode:  int(row.StatusCode.Int32),
+		Body:        row.Body,
+		Fingerprint: row.Fingerprint,
+	}
+	if len(row.Headers) > 0 {
+		if err := json.Unmarshal(row.Headers, &response.Headers); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal idempotency headers: %w", err)
+		}
+	}
+	return response, nil
+}
+
+func toIdempotencyRecord(row db.IdempotencyKey) (*gateway.IdempotencyRecord, error) {
+	record := &gateway.IdempotencyRecord{
+		IdempotencyKey: gateway.IdempotencyKey{ClientID: row.ClientID, Route: row.Route, Key: row.Key},
+		Status:         row.Status,
+		Fingerprint:    row.Fingerprint,
+		CreatedAt:      row.CreatedAt.Time,
+		ExpiresAt:      row.ExpiresAt.Time,
+	}
+	if row.Status == gateway.IdempotencyStatusCompleted {
+		response, err := toCachedResponse(row)
+		if err != nil {
+			return nil, err
+		}
+		record.Response = response
+	}
+	if row.LockedUntil.Valid {
+		record.LockedUntil = &row.LockedUntil.Time
+	}
+	if row.CompletedAt.Valid {
+		record.CompletedAt = &row.CompletedAt.Time
+	}
+	return record, nil
+}
+
+// Helper para converter time.Duration -> pgtype.Interval
+func durationToPgType(d time.Duration) pgtype.Interval {
+	return pgtype.Interval{Microseconds: d.Microseconds(), Valid: true}
+}
+
+// Helper para filtros opcionais (vazio = NULL = qualquer valor)
+func filterToPgType(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
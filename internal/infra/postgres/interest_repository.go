@@ -0,0 +1,316 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// InterestRepository implementa gateway.InterestRepository usando pgx/v5
+type InterestRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewInterestRepository(pool *pgxpool.Pool) *InterestRepository {
+	return &InterestRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *InterestRepository) SaveAccount(ctx context.Context, account *domain.InterestAccount) error {
+	rate, err := ratToPgType(account.AnnualRate)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.queries.UpsertInterestAccount(ctx, db.UpsertInterestAccountParams{
+		WalletID:   account.WalletID,
+		AnnualRate: rate,
+		DayCount:   account.DayCount,
+		StartDate:  dateToPgType(account.StartDate),
+	})
+	if err != nil {
+		// 23503 = foreign_key_violation: a carteira não existe
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return domain.ErrWalletNotFound
+		}
+		return fmt.Errorf("failed to save interest account: %w", err)
+	}
+
+	saved, err := toDomainInterestAccount(row)
+	if err != nil {
+		return err
+	}
+	*account = *saved
+	return nil
+}
+
+func (r *InterestRepository) GetAccount(ctx context.Context, walletID int64) (*domain.InterestAccount, error) {
+	row, err := r.queries.GetInterestAccount(ctx, walletID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInterestNotEnabled
+		}
+		return nil, fmt.Errorf("failed to get interest account: %w", err)
+	}
+	return toDomainInterestAccount(row)
+}
+
+func (r *InterestRepository) GetAccountForUpdate(ctx context.Context, walletID int64) (*domain.InterestAccount, error) {
+	row, err := r.queries.GetInterestAccountForUpdate(ctx, walletID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInterestNotEnabled
+		}
+		return nil, fmt.Errorf("failed to lock interest account: %w", err)
+	}
+	return toDomainInterestAccount(row)
+}
+
+func (r *InterestRepository) ListAccountsForAccrual(ctx context.Context, date time.Time) ([]domain.InterestAccount, error) {
+	rows, err := r.queries.ListInterestAccountsForAccrual(ctx, dateToPgType(date))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interest accounts: %w", err)
+	}
+
+	accounts := make([]domain.InterestAccount, 0, len(rows))
+	for _, row := range rows {
+		account, err := toDomainInterestAccount(row)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, *account)
+	}
+	return accounts, nil
+}
+
+func (r *InterestRepository) UpdateCarry(ctx context.Context, walletID int64, carryUnits *big.Int) error {
+	err := r.queries.UpdateInterestAccountCarry(ctx, db.UpdateInterestAccountCarryParams{
+		WalletID:   walletID,
+		CarryUnits: bigIntToPgType(carryUnits),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update interest carry: %w", err)
+	}
+	return nil
+}
+
+func (r *InterestRepository) CreateAccrual(ctx context.Context, accrual *domain.InterestAccrual) (bool, error) {
+	rate, err := ratToPgType(accrual.AnnualRate)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := r.queries.CreateInterestAccrual(ctx, db.CreateInterestAccrualParams{
+		WalletID:    accrual.WalletID,
+		AccrualDate: dateToPgType(accrual.Date),
+		Balance:     accrual.Balance,
+		AnnualRate:  rate,
+		DayCount:    accrual.DayCount,
+		AmountUnits: bigIntToPgType(accrual.Units),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to create interest accrual: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *InterestRepository) ListWalletsWithUnpaidAccruals(ctx context.Context, until time.Time) ([]int64, error) {
+	walletIDs, err := r.queries.ListWalletsWithUnpaidInterest(ctx, dateToPgType(until))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets with unpaid interest: %w", err)
+	}
+	return walletIDs, nil
+}
+
+func (r *InterestRepository) SumUnpaidAccruals(ctx context.Context, walletID int64, until time.Time) (*big.Int, error) {
+	units, err := r.queries.SumUnpaidInterestAccruals(ctx, db.SumUnpaidInterestAccrualsParams{
+		WalletID: walletID,
+		Until:    dateToPgType(until),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum unpaid interest: %w", err)
+	}
+	return pgTypeToBigInt(units)
+}
+
+func (r *InterestRepository) GetPayout(ctx context.Context, walletID int64, periodStart time.Time) (*domain.InterestPayout, error) {
+	row, err := r.queries.GetInterestPayout(ctx, db.GetInterestPayoutParams{
+		WalletID:    walletID,
+		PeriodStart: dateToPgType(periodStart),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get interest payout: %w", err)
+	}
+	payout := toDomainInterestPayout(row)
+	return &payout, nil
+}
+
+func (r *InterestRepository) CreatePayout(ctx context.Context, payout *domain.InterestPayout, periodEnd time.Time) error {
+	transactionID, err := uuidToPgType(payout.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.queries.CreateInterestPayout(ctx, db.CreateInterestPayoutParams{
+		WalletID:      payout.WalletID,
+		PeriodStart:   dateToPgType(payout.PeriodStart),
+		Amount:        payout.Amount,
+		TransactionID: transactionID,
+	})
+	if err != nil {
+		// 23505 = unique_violation: o mês já foi pago para a carteira
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrInterestAlreadyPaid
+		}
+		return fmt.Errorf("failed to create interest payout: %w", err)
+	}
+
+	err = r.queries.MarkInterestAccrualsPaid(ctx, db.MarkInterestAccrualsPaidParams{
+		PayoutID: pgtype.Int8{Int64: row.ID, Valid: true},
+		WalletID: payout.WalletID,
+		Until:    dateToPgType(periodEnd),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark interest accruals as paid: %w", err)
+	}
+
+	*payout = toDomainInterestPayout(row)
+	return nil
+}
+
+func (r *InterestRepository) ListPayouts(ctx context.Context, walletID int64, limit int32) ([]domain.InterestPayout, error) {
+	rows, err := r.queries.ListInterestPayouts(ctx, db.ListInterestPayoutsParams{
+		WalletID: walletID,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list interest payouts: %w", err)
+	}
+
+	payouts := make([]domain.InterestPayout, 0, len(rows))
+	for _, row := range rows {
+		payouts = append(payouts, toDomainInterestPayout(row))
+	}
+	return payouts, nil
+}
+
+func (r *InterestRepository) WithTx(tx gateway.TransactionObject) gateway.InterestRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &InterestRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainInterestAccount(a db.InterestAccount) (*domain.InterestAccount, error) {
+	rate, err := pgTypeToInterestRate(a.AnnualRate)
+	if err != nil {
+		return nil, err
+	}
+	carry, err := pgTypeToBigInt(a.CarryUnits)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.InterestAccount{
+		WalletID:   a.WalletID,
+		AnnualRate: rate,
+		DayCount:   a.DayCount,
+		StartDate:  pgTypeToDate(a.StartDate),
+		CarryUnits: carry,
+		UpdatedAt:  a.UpdatedAt.Time,
+	}, nil
+}
+
+func toDomainInterestPayout(p db.InterestPayout) domain.InterestPayout {
+	payout := domain.InterestPayout{
+		ID:          p.ID,
+		WalletID:    p.WalletID,
+		PeriodStart: pgTypeToDate(p.PeriodStart),
+		Amount:      p.Amount,
+		CreatedAt:   p.CreatedAt.Time,
+	}
+	if p.TransactionID.Valid {
+		payout.TransactionID = p.TransactionID.String()
+	}
+	return payout
+}
+
+// Helper para converter uma data de Brasília -> pgtype.Date (só o dia importa)
+func dateToPgType(t time.Time) pgtype.Date {
+	return pgtype.Date{Time: t, Valid: true}
+}
+
+// Helper para converter pgtype.Date -> meia-noite da data em Brasília
+func pgTypeToDate(d pgtype.Date) time.Time {
+	return time.Date(d.Time.Year(), d.Time.Month(), d.Time.Day(), 0, 0, 0, 0, domain.BusinessLocation)
+}
+
+// Helper para converter *big.Int -> pgtype.Numeric inteiro
+func bigIntToPgType(i *big.Int) pgtype.Numeric {
+	if i == nil {
+		i = new(big.Int)
+	}
+	return pgtype.Numeric{Int: new(big.Int).Set(i), Exp: 0, Valid: true}
+}
+
+// Helper para converter pgtype.Numeric inteiro -> *big.Int (NULL vira zero)
+func pgTypeToBigInt(n pgtype.Numeric) (*big.Int, error) {
+	if !n.Valid || n.Int == nil {
+		return new(big.Int), nil
+	}
+	value := new(big.Int).Set(n.Int)
+	if n.Exp == 0 {
+		return value, nil
+	}
+	exp := int64(n.Exp)
+	if exp < 0 {
+		exp = -exp
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil)
+	if n.Exp > 0 {
+		return value.Mul(value, scale), nil
+	}
+	quotient, remainder := new(big.Int).QuoRem(value, scale, new(big.Int))
+	if remainder.Sign() != 0 {
+		return nil, fmt.Errorf("numeric is not an integer: %se%d", n.Int, n.Exp)
+	}
+	return quotient, nil
+}
+
+// Helper para converter a taxa anual (aceita zero, diferente das taxas de câmbio)
+func pgTypeToInterestRate(n pgtype.Numeric) (*big.Rat, error) {
+	value, err := n.Value()
+	if err != nil {
+		return nil, fmt.Errorf("invalid numeric: %w", err)
+	}
+	text, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("invalid interest rate: %v", value)
+	}
+	rate, ok := new(big.Rat).SetString(text)
+	if !ok {
+		return nil, fmt.Errorf("invalid interest rate: %s", text)
+	}
+	return rate, nil
+}
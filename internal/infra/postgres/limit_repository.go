@@ -0,0 +1,182 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// LimitRepository implementa gateway.LimitRepository usando pgx/v5
+type LimitRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewLimitRepository(pool *pgxpool.Pool) *LimitRepository {
+	return &LimitRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *LimitRepository) GetTier(ctx context.Context, code string) (*domain.LimitTier, error) {
+	row, err := r.queries.GetLimitTier(ctx, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrLimitTierNotFound
+		}
+		return nil, fmt.Errorf("failed to get limit tier: %w", err)
+	}
+	return toDomainLimitTier(row), nil
+}
+
+func (r *LimitRepository) ListTiers(ctx context.Context) ([]domain.LimitTier, error) {
+	rows, err := r.queries.ListLimitTiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list limit tiers: %w", err)
+	}
+
+	tiers := make([]domain.LimitTier, 0, len(rows))
+	for _, row := range rows {
+		tiers = append(tiers, *toDomainLimitTier(row))
+	}
+	return tiers, nil
+}
+
+func (r *LimitRepository) SaveTier(ctx context.Context, tier *domain.LimitTier) error {
+	row, err := r.queries.UpsertLimitTier(ctx, db.UpsertLimitTierParams{
+		Code:              tier.Code,
+		MaxSingleAmount:   int8ToPgType(tier.Limits.MaxSingleAmount),
+		DailyAmount:       int8ToPgType(tier.Limits.DailyAmount),
+		DailyCount:        int8ToPgType(tier.Limits.DailyCount),
+		MonthlyAmount:     int8ToPgType(tier.Limits.MonthlyAmount),
+		MonthlyCount:      int8ToPgType(tier.Limits.MonthlyCount),
+		NightSingleAmount: int8ToPgType(tier.Limits.NightSingleAmount),
+		NightAmount:       int8ToPgType(tier.Limits.NightAmount),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save limit tier: %w", err)
+	}
+
+	*tier = *toDomainLimitTier(row)
+	return nil
+}
+
+func (r *LimitRepository) GetWalletLimits(ctx context.Context, walletID int64) (*domain.WalletLimits, error) {
+	row, err := r.queries.GetWalletLimits(ctx, walletID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &domain.WalletLimits{WalletID: walletID, Tier: domain.DefaultLimitTier}, nil
+		}
+		return nil, fmt.Errorf("failed to get wallet limits: %w", err)
+	}
+	return toDomainWalletLimits(row), nil
+}
+
+func (r *LimitRepository) SaveWalletLimits(ctx context.Context, limits *domain.WalletLimits) error {
+	row, err := r.queries.UpsertWalletLimits(ctx, db.UpsertWalletLimitsParams{
+		WalletID:          limits.WalletID,
+		TierCode:          limits.Tier,
+		MaxSingleAmount:   int8ToPgType(limits.Overrides.MaxSingleAmount),
+		DailyAmount:       int8ToPgType(limits.Overrides.DailyAmount),
+		DailyCount:        int8ToPgType(limits.Overrides.DailyCount),
+		MonthlyAmount:     int8ToPgType(limits.Overrides.MonthlyAmount),
+		MonthlyCount:      int8ToPgType(limits.Overrides.MonthlyCount),
+		NightSingleAmount: int8ToPgType(limits.Overrides.NightSingleAmount),
+		NightAmount:       int8ToPgType(limits.Overrides.NightAmount),
+	})
+	if err != nil {
+		// Faixa inexistente (FK) ou carteira inexistente
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			if pgErr.ConstraintName == "wallet_limits_tier_code_fkey" {
+				return domain.ErrLimitTierNotFound
+			}
+			return domain.ErrWalletNotFound
+		}
+		return fmt.Errorf("failed to save wallet limits: %w", err)
+	}
+
+	*limits = *toDomainWalletLimits(row)
+	return nil
+}
+
+func (r *LimitRepository) OutgoingUsage(ctx context.Context, walletID int64, windows domain.LimitWindows) (domain.OutgoingUsage, error) {
+	row, err := r.queries.GetWalletOutgoingUsage(ctx, db.GetWalletOutgoingUsageParams{
+		DayStart:   pgtype.Timestamptz{Time: windows.DayStart, Valid: true},
+		MonthStart: pgtype.Timestamptz{Time: windows.MonthStart, Valid: true},
+		NightStart: pgtype.Timestamptz{Time: windows.NightStart, Valid: true},
+		WalletID:   walletID,
+	})
+	if err != nil {
+		return domain.OutgoingUsage{}, fmt.Errorf("failed to sum outgoing entries: %w", err)
+	}
+	return domain.OutgoingUsage{
+		DailyAmount:   row.DailyAmount,
+		DailyCount:    row.DailyCount,
+		MonthlyAmount: row.MonthlyAmount,
+		MonthlyCount:  row.MonthlyCount,
+		NightAmount:   row.NightAmount,
+		NightCount:    row.NightCount,
+	}, nil
+}
+
+func (r *LimitRepository) WithTx(tx gateway.TransactionObject) gateway.LimitRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &LimitRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainLimitTier(t db.LimitTier) *domain.LimitTier {
+	return &domain.LimitTier{
+		Code: t.Code,
+		Limits: domain.VelocityLimits{
+			MaxSingleAmount:   pgTypeToInt8(t.MaxSingleAmount),
+			DailyAmount:       pgTypeToInt8(t.DailyAmount),
+			DailyCount:        pgTypeToInt8(t.DailyCount),
+			MonthlyAmount:     pgTypeToInt8(t.MonthlyAmount),
+			MonthlyCount:      pgTypeToInt8(t.MonthlyCount),
+			NightSingleAmount: pgTypeToInt8(t.NightSingleAmount),
+			NightAmount:       pgTypeToInt8(t.NightAmount),
+		},
+		UpdatedAt: t.UpdatedAt.Time,
+	}
+}
+
+func toDomainWalletLimits(l db.WalletLimit) *domain.WalletLimits {
+	return &domain.WalletLimits{
+		WalletID: l.WalletID,
+		Tier:     l.TierCode,
+		Overrides: domain.VelocityLimits{
+			MaxSingleAmount:   pgTypeToInt8(l.MaxSingleAmount),
+			DailyAmount:       pgTypeToInt8(l.DailyAmount),
+			DailyCount:        pgTypeToInt8(l.DailyCount),
+			MonthlyAmount:     pgTypeToInt8(l.MonthlyAmount),
+			MonthlyCount:      pgTypeToInt8(l.MonthlyCount),
+			NightSingleAmount: pgTypeToInt8(l.NightSingleAmount),
+			NightAmount:       pgTypeToInt8(l.NightAmount),
+		},
+		UpdatedAt: l.UpdatedAt.Time,
+	}
+}
+
+// Helper para converter pgtype.Int8 -> *int64 (NULL vira nil)
+func pgTypeToInt8(i pgtype.Int8) *int64 {
+	if !i.Valid {
+		return nil
+	}
+	return &i.Int64
+}
@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ScheduledTransferRepository implementa gateway.ScheduledTransferRepository usando pgx/v5
+type ScheduledTransferRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewScheduledTransferRepository(pool *pgxpool.Pool) *ScheduledTransferRepository {
+	return &ScheduledTransferRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *ScheduledTransferRepository) Create(ctx context.Context, schedule *domain.ScheduledTransfer) error {
+	row, err := r.queries.CreateScheduledTransfer(ctx, db.CreateScheduledTransferParams{
+		FromWalletID: schedule.FromWalletID,
+		ToWalletID:   schedule.ToWalletID,
+		Amount:       schedule.Amount,
+		Currency:     string(schedule.Currency),
+		ExecuteAt:    pgtype.Timestamptz{Time: schedule.ExecuteAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create scheduled transfer: %w", err)
+	}
+
+	*schedule = *toDomainScheduledTransfer(row)
+	return nil
+}
+
+func (r *ScheduledTransferRepository) GetByID(ctx context.Context, id string) (*domain.ScheduledTransfer, error) {
+	scheduleID, err := uuidToPgType(id)
+	if err != nil || !scheduleID.Valid {
+		return nil, domain.ErrScheduleNotFound
+	}
+
+	row, err := r.queries.GetScheduledTransfer(ctx, scheduleID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to get scheduled transfer: %w", err)
+	}
+	return toDomainScheduledTransfer(row), nil
+}
+
+func (r *ScheduledTransferRepository) ListByWallet(ctx context.Context, walletID int64, status string, limit int32) ([]domain.ScheduledTransfer, error) {
+	rows, err := r.queries.ListScheduledTransfersByWallet(ctx, db.ListScheduledTransfersByWalletParams{
+		WalletID: walletID,
+		Status:   pgtype.Text{String: status, Valid: status != ""},
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled transfers: %w", err)
+	}
+	return toDomainScheduledTransfers(rows), nil
+}
+
+func (r *ScheduledTransferRepository) Cancel(ctx context.Context, id string) error {
+	scheduleID, err := uuidToPgType(id)
+	if err != nil || !scheduleID.Valid {
+		return domain.ErrScheduleNotFound
+	}
+
+	rowsAffected, err := r.queries.CancelScheduledTransfer(ctx, scheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled transfer: %w", err)
+	}
+	// 0 linhas: ou não existe, ou já saiu de pending
+	if rowsAffected == 0 {
+		if _, err := r.GetByID(ctx, id); err != nil {
+			return err
+		}
+		return domain.ErrScheduleNotPending
+	}
+	return nil
+}
+
+func (r *ScheduledTransferRepository) ClaimDue(ctx context.Context, now, staleBefore time.Time, limit int32) ([]domain.ScheduledTransfer, error) {
+	rows, err := r.queries.ClaimDueScheduledTransfers(ctx, db.ClaimDueScheduledTransfersParams{
+		Now:         pgtype.Timestamptz{Time: now, Valid: true},
+		StaleBefore: pgtype.Timestamptz{Time: staleBefore, Valid: true},
+		BatchSize:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due scheduled transfers: %w", err)
+	}
+	return toDomainScheduledTransfers(rows), nil
+}
+
+func (r *ScheduledTransferRepository) Finish(ctx context.Context, schedule *domain.ScheduledTransfer) error {
+	scheduleID, err := uuidToPgType(schedule.ID)
+	if err != nil {
+		return err
+	}
+	transactionID, err := uuidToPgType(schedule.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := r.queries.FinishScheduledTransfer(ctx, db.FinishScheduledTransferParams{
+		Status:        schedule.Status,
+		TransactionID: transactionID,
+		FailureReason: pgtype.Text{String: schedule.FailureReason, Valid: schedule.FailureReason != ""},
+		ExecutedAt:    timeToPgType(schedule.ExecutedAt),
+		NextAttemptAt: timeToPgType(schedule.NextAttemptAt),
+		ID:            scheduleID,
+		Attempts:      schedule.Attempts,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish scheduled transfer: %w", err)
+	}
+	// 0 linhas: a reserva venceu e outro scheduler reservou (ou já concluiu) o item
+	if rowsAffected == 0 {
+		return domain.ErrScheduleClaimLost
+	}
+	return nil
+}
+
+// WithTx permite participar de uma transação existente
+func (r *ScheduledTransferRepository) WithTx(tx gateway.TransactionObject) gateway.ScheduledTransferRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &ScheduledTransferRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainScheduledTransfers(rows []db.ScheduledTransfer) []domain.ScheduledTransfer {
+	schedules := make([]domain.ScheduledTransfer, 0, len(rows))
+	for _, row := range rows {
+		schedules = append(schedules, *toDomainScheduledTransfer(row))
+	}
+	return schedules
+}
+
+func toDomainScheduledTransfer(s db.ScheduledTransfer) *domain.ScheduledTransfer {
+	schedule := &domain.ScheduledTransfer{
+		ID:            s.ID.String(),
+		FromWalletID:  s.FromWalletID,
+		ToWalletID:    s.ToWalletID,
+		Amount:        s.Amount,
+		Currency:      domain.Currency(s.Currency),
+		ExecuteAt:     s.ExecuteAt.Time,
+		Status:        s.Status,
+		Attempts:      s.Attempts,
+		FailureReason: s.FailureReason.String,
+		CreatedAt:     s.CreatedAt.Time,
+		UpdatedAt:     s.UpdatedAt.Time,
+	}
+	if s.TransactionID.Valid {
+		schedule.TransactionID = s.TransactionID.String()
+	}
+	if s.ExecutedAt.Valid {
+		schedule.ExecutedAt = &s.ExecutedAt.Time
+	}
+	if s.NextAttemptAt.Valid {
+		schedule.NextAttemptAt = &s.NextAttemptAt.Time
+	}
+	return schedule
+}
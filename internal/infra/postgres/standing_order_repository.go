@@ -0,0 +1,252 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// StandingOrderRepository implementa gateway.StandingOrderRepository usando pgx/v5
+type StandingOrderRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewStandingOrderRepository(pool *pgxpool.Pool) *StandingOrderRepository {
+	return &StandingOrderRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *StandingOrderRepository) Create(ctx context.Context, order *domain.StandingOrder) error {
+	row, err := r.queries.CreateStandingOrder(ctx, db.CreateStandingOrderParams{
+		FromWalletID:            order.FromWalletID,
+		ToWalletID:              order.ToWalletID,
+		Amount:                  order.Amount,
+		Currency:                string(order.Currency),
+		Rrule:                   order.Recurrence.String(),
+		StartAt:                 pgtype.Timestamptz{Time: order.StartAt, Valid: true},
+		InsufficientFundsPolicy: order.InsufficientFundsPolicy,
+		MaxRetries:              order.MaxRetries,
+		RetryIntervalSeconds:    int32(order.RetryInterval / time.Second),
+		NextOccurrenceAt:        timeToPgType(order.NextOccurrenceAt),
+		NextRunAt:               timeToPgType(order.NextRunAt),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create standing order: %w", err)
+	}
+
+	created, err := toDomainStandingOrder(row)
+	if err != nil {
+		return err
+	}
+	*order = *created
+	return nil
+}
+
+func (r *StandingOrderRepository) GetByID(ctx context.Context, id string) (*domain.StandingOrder, error) {
+	orderID, err := uuidToPgType(id)
+	if err != nil || !orderID.Valid {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	row, err := r.queries.GetStandingOrder(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get standing order: %w", err)
+	}
+	return toDomainStandingOrder(row)
+}
+
+func (r *StandingOrderRepository) ListByWallet(ctx context.Context, walletID int64, limit int32) ([]domain.StandingOrder, error) {
+	rows, err := r.queries.ListStandingOrdersByWallet(ctx, db.ListStandingOrdersByWalletParams{
+		WalletID: walletID,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list standing orders: %w", err)
+	}
+	return toDomainStandingOrders(rows)
+}
+
+func (r *StandingOrderRepository) Update(ctx context.Context, order *domain.StandingOrder) error {
+	orderID, err := uuidToPgType(order.ID)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.UpdateStandingOrder(ctx, db.UpdateStandingOrderParams{
+		ID:                      orderID,
+		Amount:                  order.Amount,
+		InsufficientFundsPolicy: order.InsufficientFundsPolicy,
+		MaxRetries:              order.MaxRetries,
+		RetryIntervalSeconds:    int32(order.RetryInterval / time.Second),
+		Status:                  order.Status,
+		NextOccurrenceAt:        timeToPgType(order.NextOccurrenceAt),
+		NextRunAt:               timeToPgType(order.NextRunAt),
+		Attempts:                order.Attempts,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update standing order: %w", err)
+	}
+	return nil
+}
+
+func (r *StandingOrderRepository) ClaimDue(ctx context.Context, now, claimedUntil time.Time, limit int32) ([]domain.StandingOrder, error) {
+	rows, err := r.queries.ClaimDueStandingOrders(ctx, db.ClaimDueStandingOrdersParams{
+		ClaimedUntil: pgtype.Timestamptz{Time: claimedUntil, Valid: true},
+		Now:          pgtype.Timestamptz{Time: now, Valid: true},
+		BatchSize:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due standing orders: %w", err)
+	}
+	return toDomainStandingOrders(rows)
+}
+
+func (r *StandingOrderRepository) FinishRun(ctx context.Context, order *domain.StandingOrder) error {
+	orderID, err := uuidToPgType(order.ID)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.FinishStandingOrderRun(ctx, db.FinishStandingOrderRunParams{
+		Status:            order.Status,
+		OccurrencesCount:  order.OccurrencesCount,
+		NextOccurrenceAt:  timeToPgType(order.NextOccurrenceAt),
+		NextRunAt:         timeToPgType(order.NextRunAt),
+		Attempts:          order.Attempts,
+		LastFailureReason: pgtype.Text{String: order.LastFailureReason, Valid: order.LastFailureReason != ""},
+		ID:                orderID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to finish standing order run: %w", err)
+	}
+	return nil
+}
+
+func (r *StandingOrderRepository) CreateOccurrence(ctx context.Context, occurrence *domain.StandingOrderOccurrence) error {
+	orderID, err := uuidToPgType(occurrence.StandingOrderID)
+	if err != nil {
+		return err
+	}
+	transactionID, err := uuidToPgType(occurrence.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.CreateStandingOrderOccurrence(ctx, db.CreateStandingOrderOccurrenceParams{
+		StandingOrderID: orderID,
+		OccurrenceAt:    pgtype.Timestamptz{Time: occurrence.OccurrenceAt, Valid: true},
+		Status:          occurrence.Status,
+		TransactionID:   transactionID,
+		FailureReason:   pgtype.Text{String: occurrence.FailureReason, Valid: occurrence.FailureReason != ""},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create standing order occurrence: %w", err)
+	}
+	return nil
+}
+
+func (r *StandingOrderRepository) ListOccurrences(ctx context.Context, orderID string, limit int32) ([]domain.StandingOrderOccurrence, error) {
+	id, err := uuidToPgType(orderID)
+	if err != nil || !id.Valid {
+		return nil, domain.ErrOrderNotFound
+	}
+
+	rows, err := r.queries.ListStandingOrderOccurrences(ctx, db.ListStandingOrderOccurrencesParams{
+		StandingOrderID: id,
+		PageSize:        limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list standing order occurrences: %w", err)
+	}
+
+	occurrences := make([]domain.StandingOrderOccurrence, 0, len(rows))
+	for _, row := range rows {
+		occurrence := domain.StandingOrderOccurrence{
+			ID:              row.ID,
+			StandingOrderID: row.StandingOrderID.String(),
+			OccurrenceAt:    row.OccurrenceAt.Time,
+			Status:          row.Status,
+			FailureReason:   row.FailureReason.String,
+			CreatedAt:       row.CreatedAt.Time,
+		}
+		if row.TransactionID.Valid {
+			occurrence.TransactionID = row.TransactionID.String()
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences, nil
+}
+
+func (r *StandingOrderRepository) WithTx(tx gateway.TransactionObject) gateway.StandingOrderRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &StandingOrderRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainStandingOrders(rows []db.StandingOrder) ([]domain.StandingOrder, error) {
+	orders := make([]domain.StandingOrder, 0, len(rows))
+	for _, row := range rows {
+		order, err := toDomainStandingOrder(row)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, *order)
+	}
+	return orders, nil
+}
+
+func toDomainStandingOrder(o db.StandingOrder) (*domain.StandingOrder, error) {
+	// A regra foi validada na criação: falhar aqui indica dado corrompido
+	recurrence, err := domain.ParseRRule(o.Rrule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rrule of standing order %s: %w", o.ID.String(), err)
+	}
+	if err := recurrence.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to parse rrule of standing order %s: %w", o.ID.String(), err)
+	}
+
+	order := &domain.StandingOrder{
+		ID:                      o.ID.String(),
+		FromWalletID:            o.FromWalletID,
+		ToWalletID:              o.ToWalletID,
+		Amount:                  o.Amount,
+		Currency:                domain.Currency(o.Currency),
+		Recurrence:              recurrence,
+		StartAt:                 o.StartAt.Time,
+		InsufficientFundsPolicy: o.InsufficientFundsPolicy,
+		MaxRetries:              o.MaxRetries,
+		RetryInterval:           time.Duration(o.RetryIntervalSeconds) * time.Second,
+		Status:                  o.Status,
+		OccurrencesCount:        o.OccurrencesCount,
+		Attempts:                o.Attempts,
+		LastFailureReason:       o.LastFailureReason.String,
+		CreatedAt:               o.CreatedAt.Time,
+		UpdatedAt:               o.UpdatedAt.Time,
+	}
+	if o.NextOccurrenceAt.Valid {
+		order.NextOccurrenceAt = &o.NextOccurrenceAt.Time
+	}
+	if o.NextRunAt.Valid {
+		order.NextRunAt = &o.NextRunAt.Time
+	}
+	return order, nil
+}
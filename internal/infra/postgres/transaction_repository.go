@@ -2,12 +2,15 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -25,18 +28,53 @@ func NewTransactionRepository(pool *pgxpool.Pool) *TransactionRepository {
 }
 
 func (r *TransactionRepository) Create(ctx context.Context, tx *domain.Transaction) error {
+	fxRate, err := ratToPgType(tx.FXRate)
+	if err != nil {
+		return err
+	}
+	fxQuoteID, err := uuidToPgType(tx.FXQuoteID)
+	if err != nil {
+		return err
+	}
+	originalTransactionID, err := uuidToPgType(tx.OriginalTransactionID)
+	if err != nil {
+		return err
+	}
+
+	if tx.Kind == "" {
+		tx.Kind = domain.TransactionKindTransfer
+	}
+
 	// Conversão do domínio para o formato do SQLC
 	params := db.CreateTransactionParams{
-		FromWalletID: tx.FromWalletID,
-		ToWalletID:   tx.ToWalletID,
+		// Zero vira NULL (lançamentos journal não têm origem/destino únicos)
+		FromWalletID: pgtype.Int8{Int64: tx.FromWalletID, Valid: tx.FromWalletID != 0},
+		ToWalletID:   pgtype.Int8{Int64: tx.ToWalletID, Valid: tx.ToWalletID != 0},
 		Amount:       tx.Amount,
 		Status:       tx.Status,
+		Currency:     string(tx.Currency),
 		// IdempotencyKey é *string no domínio, mas pgtype.Text no banco
-		IdempotencyKey: textToPgType(tx.IdempotencyKey),
+		IdempotencyKey:   textToPgType(tx.IdempotencyKey),
+		IdempotencyScope: tx.IdempotencyScope,
+		// Campos de câmbio: zero/vazio viram NULL
+		DestinationAmount:   pgtype.Int8{Int64: tx.DestinationAmount, Valid: tx.DestinationAmount != 0},
+		DestinationCurrency: pgtype.Text{String: string(tx.DestinationCurrency), Valid: tx.DestinationCurrency != ""},
+		FxRate:              fxRate,
+		FxQuoteID:           fxQuoteID,
+		// Preenchido apenas em estornos
+		OriginalTransactionID: originalTransactionID,
+		Kind:                  tx.Kind,
+		Description:           pgtype.Text{String: tx.Description, Valid: tx.Description != ""},
+		FeeAmount:             tx.FeeAmount,
 	}
 
 	row, err := r.queries.CreateTransaction(ctx, params)
 	if err != nil {
+		// 23505 = unique_violation: a Idempotency-Key já gerou outra transação
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_transactions_idem_key" {
+			return domain.ErrIdempotencyKey
+		}
 		return fmt.Errorf("failed to create transaction: %w", err)
 	}
 
@@ -47,6 +85,145 @@ func (r *TransactionRepository) Create(ctx context.Context, tx *domain.Transacti
 	return nil
 }
 
+func (r *TransactionRepository) GetByID(ctx context.Context, id string) (*domain.Transaction, error) {
+	transactionID, err := uuidToPgType(id)
+	if err != nil || !transactionID.Valid {
+		// ID malformado nunca vai existir no banco
+		return nil, domain.ErrTransactionNotFound
+	}
+
+	row, err := r.queries.GetTransaction(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	return toDomainTransaction(row)
+}
+
+func (r *TransactionRepository) GetByIDForUpdate(ctx context.Context, id string) (*domain.Transaction, error) {
+	transactionID, err := uuidToPgType(id)
+	if err != nil || !transactionID.Valid {
+		return nil, domain.ErrTransactionNotFound
+	}
+
+	row, err := r.queries.GetTransactionForUpdate(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to lock transaction: %w", err)
+	}
+	return toDomainTransaction(row)
+}
+
+func (r *TransactionRepository) UpdateRefund(ctx context.Context, tx *domain.Transaction) error {
+	transactionID, err := uuidToPgType(tx.ID)
+	if err != nil {
+		return err
+	}
+
+	err = r.queries.UpdateTransactionRefund(ctx, db.UpdateTransactionRefundParams{
+		ID:             transactionID,
+		RefundedAmount: tx.RefundedAmount,
+		Status:         tx.Status,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update transaction refund: %w", err)
+	}
+	return nil
+}
+
+func (r *TransactionRepository) GetByIdempotencyKey(ctx context.Context, scope, key string) (*domain.Transaction, error) {
+	row, err := r.queries.GetTransactionByIdempotencyKey(ctx, db.GetTransactionByIdempotencyKeyParams{
+		IdempotencyScope: scope,
+		IdempotencyKey:   textToPgType(&key),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction by idempotency key: %w", err)
+	}
+	return toDomainTransaction(row)
+}
+
+func (r *TransactionRepository) ListByWallet(ctx context.Context, filter gateway.TransactionFilter) ([]domain.WalletTransaction, error) {
+	rows, err := r.queries.ListTransactions(ctx, db.ListTransactionsParams{
+		WalletID:  filter.WalletID,
+		Cursor:    int8ToPgType(filter.AfterEntryID),
+		Direction: pgtype.Text{String: filter.Direction, Valid: filter.Direction != ""},
+		FromDate:  timeToPgType(filter.From),
+		ToDate:    timeToPgType(filter.To),
+		MinAmount: int8ToPgType(filter.MinAmount),
+		MaxAmount: int8ToPgType(filter.MaxAmount),
+		Status:    pgtype.Text{String: filter.Status, Valid: filter.Status != ""},
+		PageSize:  filter.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	items := make([]domain.WalletTransaction, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, domain.WalletTransaction{
+			Transaction: domain.Transaction{
+				ID:             row.ID.String(),
+				Kind:           row.Kind,
+				FromWalletID:   row.FromWalletID.Int64,
+				ToWalletID:     row.ToWalletID.Int64,
+				Amount:         row.Amount,
+				Currency:       domain.Currency(row.Currency),
+				Status:         row.Status,
+				IdempotencyKey: pgTypeToText(row.IdempotencyKey),
+				FeeAmount:      row.FeeAmount,
+				CreatedAt:      row.CreatedAt.Time,
+			},
+			EntryID:      row.EntryID,
+			SignedAmount: row.SignedAmount,
+		})
+	}
+	return items, nil
+}
+
+func (r *TransactionRepository) ListStatement(ctx context.Context, walletID int64, since, until time.Time) ([]domain.WalletTransaction, error) {
+	rows, err := r.queries.ListWalletStatement(ctx, db.ListWalletStatementParams{
+		WalletID: walletID,
+		Since:    pgtype.Timestamptz{Time: since, Valid: true},
+		Until:    pgtype.Timestamptz{Time: until, Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallet statement: %w", err)
+	}
+
+	items := make([]domain.WalletTransaction, 0, len(rows))
+	for _, row := range rows {
+		item := domain.WalletTransaction{
+			Transaction: domain.Transaction{
+				Kind:         row.Kind.String,
+				FromWalletID: row.FromWalletID.Int64,
+				ToWalletID:   row.ToWalletID.Int64,
+				Currency:     domain.Currency(row.Currency),
+				Status:       row.Status.String,
+				Description:  row.Description.String,
+				FeeAmount:    row.FeeAmount.Int64,
+				CreatedAt:    row.CreatedAt.Time,
+			},
+			EntryID:      row.EntryID,
+			SignedAmount: row.SignedAmount,
+		}
+		if row.ID.Valid {
+			item.ID = row.ID.String()
+		}
+		if row.OriginalTransactionID.Valid {
+			item.OriginalTransactionID = row.OriginalTransactionID.String()
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
 func (r *TransactionRepository) WithTx(tx gateway.TransactionObject) gateway.TransactionRepository {
 	pgTx, ok := tx.(pgx.Tx)
 	if !ok {
@@ -58,6 +235,40 @@ func (r *TransactionRepository) WithTx(tx gateway.TransactionObject) gateway.Tra
 	}
 }
 
+// Mapper: pgtype -> Go types
+func toDomainTransaction(t db.Transaction) (*domain.Transaction, error) {
+	fxRate, err := pgTypeToRat(t.FxRate)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction := &domain.Transaction{
+		ID:                  t.ID.String(),
+		Kind:                t.Kind,
+		FromWalletID:        t.FromWalletID.Int64,
+		ToWalletID:          t.ToWalletID.Int64,
+		Amount:              t.Amount,
+		Currency:            domain.Currency(t.Currency),
+		Status:              t.Status,
+		IdempotencyKey:      pgTypeToText(t.IdempotencyKey),
+		IdempotencyScope:    t.IdempotencyScope,
+		Description:         t.Description.String,
+		FeeAmount:           t.FeeAmount,
+		CreatedAt:           t.CreatedAt.Time,
+		DestinationAmount:   t.DestinationAmount.Int64,
+		DestinationCurrency: domain.Currency(t.DestinationCurrency.String),
+		FXRate:              fxRate,
+		RefundedAmount:      t.RefundedAmount,
+	}
+	if t.FxQuoteID.Valid {
+		transaction.FXQuoteID = t.FxQuoteID.String()
+	}
+	if t.OriginalTransactionID.Valid {
+		transaction.OriginalTransactionID = t.OriginalTransactionID.String()
+	}
+	return transaction, nil
+}
+
 // Helper para converter *string -> pgtype.Text
 func textToPgType(s *string) pgtype.Text {
 	if s == nil {
@@ -65,3 +276,27 @@ func textToPgType(s *string) pgtype.Text {
 	}
 	return pgtype.Text{String: *s, Valid: true}
 }
+
+// Helper para converter pgtype.Text -> *string
+func pgTypeToText(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+// Helper para converter *int64 -> pgtype.Int8
+func int8ToPgType(i *int64) pgtype.Int8 {
+	if i == nil {
+		return pgtype.Int8{Valid: false}
+	}
+	return pgtype.Int8{Int64: *i, Valid: true}
+}
+
+// Helper para converter *time.Time -> pgtype.Timestamptz
+func timeToPgType(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{Valid: false}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
@@ -20,10 +20,18 @@ func NewUow(pool *pgxpool.Pool) *Uow {
 
 // Run executa uma função dentro de uma transação ACID.
 // Se a função retornar erro, faz Rollback. Se sucesso, Commit.
+// Se o contexto já carrega uma transação (ex: a do middleware de idempotência), abre um SAVEPOINT
+// nela: o Rollback desfaz só esta parte e o Commit definitivo fica com quem abriu a transação de fora.
 func (u *Uow) Run(ctx context.Context, fn func(ctx context.Context) error) error {
-	tx, err := u.pool.BeginTx(ctx, pgx.TxOptions{
-		IsoLevel: pgx.ReadCommitted, // Ou Serializable para proteção máxima
-	})
+	var tx pgx.Tx
+	var err error
+	if outer, ok := ctx.Value(gateway.TransactionKey).(pgx.Tx); ok {
+		tx, err = outer.Begin(ctx)
+	} else {
+		tx, err = u.pool.BeginTx(ctx, pgx.TxOptions{
+			IsoLevel: pgx.ReadCommitted, // Ou Serializable para proteção máxima
+		})
+	}
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
 	}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WalletLimitChangeRepository implementa gateway.WalletLimitChangeRepository usando pgx/v5
+type WalletLimitChangeRepository struct {
+	db      *pgxpool.Pool
+	queries *db.Queries
+}
+
+func NewWalletLimitChangeRepository(pool *pgxpool.Pool) *WalletLimitChangeRepository {
+	return &WalletLimitChangeRepository{
+		db:      pool,
+		queries: db.New(pool),
+	}
+}
+
+func (r *WalletLimitChangeRepository) Create(ctx context.Context, change *domain.WalletLimitChange) error {
+	row, err := r.queries.CreateWalletLimitChange(ctx, db.CreateWalletLimitChangeParams{
+		WalletID:      change.WalletID,
+		PreviousLimit: change.PreviousLimit,
+		NewLimit:      change.NewLimit,
+		ChangedBy:     change.ChangedBy,
+		Reason:        change.Reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create wallet limit change: %w", err)
+	}
+
+	*change = *toDomainWalletLimitChange(row)
+	return nil
+}
+
+func (r *WalletLimitChangeRepository) ListByWallet(ctx context.Context, walletID int64, limit int32) ([]*domain.WalletLimitChange, error) {
+	rows, err := r.queries.ListWalletLimitChanges(ctx, db.ListWalletLimitChangesParams{
+		WalletID: walletID,
+		PageSize: limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallet limit changes: %w", err)
+	}
+
+	changes := make([]*domain.WalletLimitChange, 0, len(rows))
+	for _, row := range rows {
+		changes = append(changes, toDomainWalletLimitChange(row))
+	}
+	return changes, nil
+}
+
+func (r *WalletLimitChangeRepository) WithTx(tx gateway.TransactionObject) gateway.WalletLimitChangeRepository {
+	pgTx, ok := tx.(pgx.Tx)
+	if !ok {
+		return r
+	}
+	return &WalletLimitChangeRepository{
+		db:      r.db,
+		queries: r.queries.WithTx(pgTx),
+	}
+}
+
+func toDomainWalletLimitChange(c db.WalletLimitChange) *domain.WalletLimitChange {
+	return &domain.WalletLimitChange{
+		ID:            c.ID,
+		WalletID:      c.WalletID,
+		PreviousLimit: c.PreviousLimit,
+		NewLimit:      c.NewLimit,
+		ChangedBy:     c.ChangedBy,
+		Reason:        c.Reason,
+		CreatedAt:     c.CreatedAt.Time,
+	}
+}
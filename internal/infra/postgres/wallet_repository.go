@@ -9,6 +9,7 @@ import (
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/infra/postgres/db"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -27,9 +28,18 @@ func NewWalletRepository(pool *pgxpool.Pool) *WalletRepository {
 }
 
 // Create insere uma nova carteira
-func (r *WalletRepository) Create(ctx context.Context, balance int64) (*domain.Wallet, error) {
-	modelWallet, err := r.queries.CreateWallet(ctx, balance)
+func (r *WalletRepository) Create(ctx context.Context, customerID int64, balance domain.Money) (*domain.Wallet, error) {
+	modelWallet, err := r.queries.CreateWallet(ctx, db.CreateWalletParams{
+		Balance:    balance.Amount,
+		Currency:   string(balance.Currency),
+		CustomerID: int8ToPgType(&customerID),
+	})
 	if err != nil {
+		// 23503 = foreign_key_violation: o cliente não existe
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return nil, domain.ErrCustomerNotFound
+		}
 		return nil, fmt.Errorf("failed to create wallet: %w", err)
 	}
 	return toDomainWallet(modelWallet), nil
@@ -48,6 +58,35 @@ func (r *WalletRepository) GetByID(ctx context.Context, id int64) (*domain.Walle
 	return toDomainWallet(modelWallet), nil
 }
 
+// ListByCustomer lista as carteiras de um cliente
+func (r *WalletRepository) ListByCustomer(ctx context.Context, customerID int64) ([]domain.Wallet, error) {
+	rows, err := r.queries.ListWalletsByCustomer(ctx, int8ToPgType(&customerID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list customer wallets: %w", err)
+	}
+
+	wallets := make([]domain.Wallet, 0, len(rows))
+	for _, row := range rows {
+		wallets = append(wallets, *toDomainWallet(row))
+	}
+	return wallets, nil
+}
+
+// GetSystemWallet busca uma carteira da instituição pelo código e moeda
+func (r *WalletRepository) GetSystemWallet(ctx context.Context, code string, currency domain.Currency) (*domain.Wallet, error) {
+	modelWallet, err := r.queries.GetSystemWallet(ctx, db.GetSystemWalletParams{
+		SystemCode: textToPgType(&code),
+		Currency:   string(currency),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWalletNotFound
+		}
+		return nil, fmt.Errorf("failed to get system wallet %s/%s: %w", code, currency, err)
+	}
+	return toDomainWallet(modelWallet), nil
+}
+
 // 🔐 Implementação do Lock
 func (r *WalletRepository) GetByIDForUpdate(ctx context.Context, id int64) (*domain.Wallet, error) {
 	// Chama a query com "FOR UPDATE"
@@ -74,7 +113,7 @@ func (r *WalletRepository) Debit(ctx context.Context, id int64, amount int64) er
 		return fmt.Errorf("failed to debit wallet: %w", err)
 	}
 
-	// Se 0 linhas foram afetadas, a cláusula "AND balance >= amount" falhou
+	// Se 0 linhas foram afetadas, a cláusula "AND balance - held_amount + overdraft_limit >= amount" falhou
 	if rowsAffected == 0 {
 		return domain.ErrInsufficientFunds
 	}
@@ -91,6 +130,64 @@ func (r *WalletRepository) Credit(ctx context.Context, id int64, amount int64) e
 	return r.queries.CreditWallet(ctx, params)
 }
 
+// 🔒 Reserva Atômica (Valida saldo disponível no banco)
+func (r *WalletRepository) Hold(ctx context.Context, id int64, amount int64) error {
+	rowsAffected, err := r.queries.HoldWalletFunds(ctx, db.HoldWalletFundsParams{
+		Amount: amount,
+		ID:     id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to hold wallet funds: %w", err)
+	}
+
+	// Se 0 linhas foram afetadas, a cláusula "AND balance - held_amount + overdraft_limit >= amount" falhou
+	if rowsAffected == 0 {
+		return domain.ErrInsufficientFunds
+	}
+	return nil
+}
+
+// 🔓 Libera uma reserva feita por Hold
+func (r *WalletRepository) Release(ctx context.Context, id int64, amount int64) error {
+	rowsAffected, err := r.queries.ReleaseWalletFunds(ctx, db.ReleaseWalletFundsParams{
+		Amount: amount,
+		ID:     id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to release wallet funds: %w", err)
+	}
+
+	// Liberar mais do que está reservado indica reserva já liberada (ou carteira inexistente)
+	if rowsAffected == 0 {
+		return fmt.Errorf("failed to release wallet funds: wallet %d does not hold %d", id, amount)
+	}
+	return nil
+}
+
+// UpdateOverdraftLimit grava o novo limite de cheque especial da carteira
+func (r *WalletRepository) UpdateOverdraftLimit(ctx context.Context, id int64, limit int64) error {
+	err := r.queries.UpdateWalletOverdraftLimit(ctx, db.UpdateWalletOverdraftLimitParams{
+		ID:             id,
+		OverdraftLimit: limit,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update overdraft limit: %w", err)
+	}
+	return nil
+}
+
+// UpdateStatus grava o novo status da carteira (as transições são validadas no domínio)
+func (r *WalletRepository) UpdateStatus(ctx context.Context, id int64, status string) error {
+	err := r.queries.UpdateWalletStatus(ctx, db.UpdateWalletStatusParams{
+		ID:     id,
+		Status: status,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update wallet status: %w", err)
+	}
+	return nil
+}
+
 // WithTx retorna uma cópia do repositório usando uma transação específica
 func (r *WalletRepository) WithTx(tx gateway.TransactionObject) gateway.WalletRepository {
 	pgTx, ok := tx.(pgx.Tx)
@@ -106,9 +203,15 @@ func (r *WalletRepository) WithTx(tx gateway.TransactionObject) gateway.WalletRe
 // Mapper: pgtype -> Go types
 func toDomainWallet(w db.Wallet) *domain.Wallet {
 	return &domain.Wallet{
-		ID:      w.ID,
-		Balance: w.Balance,
-		Version: w.Version,
+		ID:             w.ID,
+		Balance:        w.Balance,
+		HeldAmount:     w.HeldAmount,
+		OverdraftLimit: w.OverdraftLimit,
+		Currency:       domain.Currency(w.Currency),
+		SystemCode:     w.SystemCode.String,
+		Status:         w.Status,
+		CustomerID:     pgTypeToInt8(w.CustomerID),
+		Version:        w.Version,
 		//  pgtype.Timestamptz é uma struct, acessamos o valor .Time
 		CreatedAt: w.CreatedAt.Time,
 		UpdatedAt: w.UpdatedAt.Time,
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/rs/zerolog/log"
 )
@@ -23,7 +24,20 @@ func (p *RabbitMQPublisher) Publish(ctx context.Context, exchange, routingKey st
 		return fmt.Errorf("failed to marshal event: %w", err)
 	}
 
-	err = p.channel.PublishWithContext(ctx,
+	// Dentro de uma transação que ainda vai ser efetivada, o evento espera o Commit
+	if pending := gateway.PendingEventsFromContext(ctx); pending != nil {
+		pending.Add(func(ctx context.Context) {
+			if err := p.publish(ctx, exchange, routingKey, bytes); err != nil {
+				log.Error().Err(err).Str("routing_key", routingKey).Msg("Falha ao publicar evento após o commit")
+			}
+		})
+		return nil
+	}
+	return p.publish(ctx, exchange, routingKey, bytes)
+}
+
+func (p *RabbitMQPublisher) publish(ctx context.Context, exchange, routingKey string, bytes []byte) error {
+	err := p.channel.PublishWithContext(ctx,
 		exchange,   // exchange
 		routingKey, // routing key
 		false,      // mandatory
@@ -0,0 +1,93 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+	"github.com/rs/zerolog/log"
+)
+
+// cachedResponseTTL: quanto tempo uma resposta lida do banco fica no Redis
+const cachedResponseTTL = time.Hour
+
+// DurableIdempotencyRepository é o armazenamento por trás do cache (o Postgres)
+type DurableIdempotencyRepository interface {
+	gateway.IdempotencyRepository
+	gateway.IdempotencyAdminRepository
+}
+
+// CachedIdempotencyRepository coloca o Redis como cache de leitura (read-through) na frente do
+// repositório durável (Postgres). O banco é a fonte da verdade: reservas e respostas são gravadas
+// só nele (a resposta na transação da operação); o Redis só recebe respostas já confirmadas, na leitura.
+// Com o Redis fora do ar, tudo segue pelo banco.
+type CachedIdempotencyRepository struct {
+	cache   *IdempotencyRepository
+	durable gateway.IdempotencyRepository
+	admin   gateway.IdempotencyAdminRepository
+}
+
+func NewCachedIdempotencyRepository(cache *IdempotencyRepository, durable DurableIdempotencyRepository) *CachedIdempotencyRepository {
+	return &CachedIdempotencyRepository{
+		cache:   cache,
+		durable: durable,
+		admin:   durable,
+	}
+}
+
+func (r *CachedIdempotencyRepository) Get(ctx context.Context, key gateway.IdempotencyKey) (*gateway.CachedResponse, error) {
+	cached, err := r.cache.Get(ctx, key)
+	if err != nil {
+		log.Warn().Err(err).Msg("Cache de idempotência indisponível, consultando o banco")
+	}
+	if cached != nil {
+		return cached, nil
+	}
+
+	response, err := r.durable.Get(ctx, key)
+	if err != nil || response == nil {
+		return response, err
+	}
+	if err := r.cache.Save(ctx, key, *response, cachedResponseTTL); err != nil {
+		log.Warn().Err(err).Msg("Falha ao popular cache de idempotência")
+	}
+	return response, nil
+}
+
+// Save grava só no banco: dentro de uma transação, a resposta ainda pode ser desfeita
+func (r *CachedIdempotencyRepository) Save(ctx context.Context, key gateway.IdempotencyKey, response gateway.CachedResponse, ttl time.Duration) error {
+	return r.durable.Save(ctx, key, response, ttl)
+}
+
+func (r *CachedIdempotencyRepository) Reserve(ctx context.Context, key gateway.IdempotencyKey, lock gateway.IdempotencyLock, lease time.Duration) (bool, *gateway.IdempotencyLock, error) {
+	return r.durable.Reserve(ctx, key, lock, lease)
+}
+
+func (r *CachedIdempotencyRepository) Release(ctx context.Context, key gateway.IdempotencyKey, lock gateway.IdempotencyLock) error {
+	return r.durable.Release(ctx, key, lock)
+}
+
+func (r *CachedIdempotencyRepository) List(ctx context.Context, filter gateway.IdempotencyFilter) ([]gateway.IdempotencyRecord, error) {
+	return r.admin.List(ctx, filter)
+}
+
+// Purge apaga no banco e tira do cache o que foi apagado, senão o Redis continuaria devolvendo a resposta
+func (r *CachedIdempotencyRepository) Purge(ctx context.Context, filter gateway.IdempotencyFilter) ([]gateway.IdempotencyKey, error) {
+	keys, err := r.admin.Purge(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.cache.Delete(ctx, keys...); err != nil {
+		// A resposta cacheada ainda pode ser devolvida por até cachedResponseTTL
+		log.Warn().Err(err).Msg("Falha ao remover chaves de idempotência do cache")
+	}
+	return keys, nil
+}
+
+func (r *CachedIdempotencyRepository) WithTx(tx gateway.TransactionObject) gateway.IdempotencyRepository {
+	return &CachedIdempotencyRepository{
+		cache:   r.cache,
+		durable: r.durable.WithTx(tx),
+		admin:   r.admin,
+	}
+}
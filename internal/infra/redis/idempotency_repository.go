@@ -5,12 +5,21 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
 	"github.com/redis/go-redis/v9"
 )
 
+// releaseScript apaga a reserva só se o valor ainda for o nosso (compare-and-delete atômico)
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
 type IdempotencyRepository struct {
 	client *redis.Client
 }
@@ -19,8 +28,8 @@ func NewIdempotencyRepository(client *redis.Client) *IdempotencyRepository {
 	return &IdempotencyRepository{client: client}
 }
 
-func (r *IdempotencyRepository) Get(ctx context.Context, key string) (*gateway.CachedResponse, error) {
-	val, err := r.client.Get(ctx, "idempotency:"+key).Result()
+func (r *IdempotencyRepository) Get(ctx context.Context, key gateway.IdempotencyKey) (*gateway.CachedResponse, error) {
+	val, err := r.client.Get(ctx, responseKey(key)).Result()
 	if errors.Is(err, redis.Nil) {
 		return nil, nil // Não encontrado (cache miss)
 	}
@@ -36,11 +45,81 @@ func (r *IdempotencyRepository) Get(ctx context.Context, key string) (*gateway.C
 	return &resp, nil
 }
 
-func (r *IdempotencyRepository) Save(ctx context.Context, key string, response gateway.CachedResponse, ttl time.Duration) error {
+func (r *IdempotencyRepository) Save(ctx context.Context, key gateway.IdempotencyKey, response gateway.CachedResponse, ttl time.Duration) error {
 	bytes, err := json.Marshal(response)
 	if err != nil {
 		return fmt.Errorf("failed to marshal response: %w", err)
 	}
 
-	return r.client.Set(ctx, "idempotency:"+key, bytes, ttl).Err()
+	return r.client.Set(ctx, responseKey(key), bytes, ttl).Err()
+}
+
+func (r *IdempotencyRepository) Reserve(ctx context.Context, key gateway.IdempotencyKey, lock gateway.IdempotencyLock, lease time.Duration) (bool, *gateway.IdempotencyLock, error) {
+	acquired, err := r.client.SetNX(ctx, lockKey(key), encodeLock(lock), lease).Result()
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if acquired {
+		return true, nil, nil
+	}
+
+	val, err := r.client.Get(ctx, lockKey(key)).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil, nil // A reserva expirou ou foi liberada entre o SET NX e o GET
+	}
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to get idempotency reservation: %w", err)
+	}
+	holder := decodeLock(val)
+	return false, &holder, nil
+}
+
+func (r *IdempotencyRepository) Release(ctx context.Context, key gateway.IdempotencyKey, lock gateway.IdempotencyLock) error {
+	if err := releaseScript.Run(ctx, r.client, []string{lockKey(key)}, encodeLock(lock)).Err(); err != nil {
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Delete apaga as respostas cacheadas e as reservas das chaves (expurgo administrativo)
+func (r *IdempotencyRepository) Delete(ctx context.Context, keys ...gateway.IdempotencyKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	redisKeys := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		redisKeys = append(redisKeys, responseKey(key), lockKey(key))
+	}
+	if err := r.client.Del(ctx, redisKeys...).Err(); err != nil {
+		return fmt.Errorf("failed to delete idempotency keys: %w", err)
+	}
+	return nil
+}
+
+// WithTx: o Redis não participa da transação do banco
+func (r *IdempotencyRepository) WithTx(tx gateway.TransactionObject) gateway.IdempotencyRepository {
+	return r
+}
+
+// As chaves do Redis carregam o escopo: "idempotency:<cliente>:<rota>:<chave>"
+func responseKey(key gateway.IdempotencyKey) string {
+	return "idempotency:" + scopedKey(key)
+}
+
+func lockKey(key gateway.IdempotencyKey) string {
+	return "idempotency:lock:" + scopedKey(key)
+}
+
+func scopedKey(key gateway.IdempotencyKey) string {
+	return key.ClientID + ":" + key.Route + ":" + key.Key
+}
+
+// A reserva é gravada como "<token>:<fingerprint>" (nenhum dos dois contém ':')
+func encodeLock(lock gateway.IdempotencyLock) string {
+	return lock.Token + ":" + lock.Fingerprint
+}
+
+func decodeLock(val string) gateway.IdempotencyLock {
+	token, fingerprint, _ := strings.Cut(val, ":")
+	return gateway.IdempotencyLock{Token: token, Fingerprint: fingerprint}
 }
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type AccrueInterestOutput struct {
+	Accrued        int // Carteiras que renderam na data
+	AlreadyAccrued int // Carteiras que já tinham rendido (reexecução do job)
+	Failed         int
+}
+
+// AccrueInterestUseCase registra o rendimento de um dia de todas as carteiras de poupança,
+// sobre o saldo de fim de dia reconstruído pelas partidas. Reexecutar para a mesma data não rende de novo.
+type AccrueInterestUseCase struct {
+	interestRepository gateway.InterestRepository
+	entryRepository    gateway.EntryRepository
+}
+
+func NewAccrueInterest(interestRepo gateway.InterestRepository, entryRepo gateway.EntryRepository) *AccrueInterestUseCase {
+	return &AccrueInterestUseCase{
+		interestRepository: interestRepo,
+		entryRepository:    entryRepo,
+	}
+}
+
+// Execute rende a data (dia do calendário de Brasília). O dia precisa ter terminado.
+func (u *AccrueInterestUseCase) Execute(ctx context.Context, date time.Time, now time.Time) (*AccrueInterestOutput, error) {
+	date = domain.BusinessDate(date)
+	dayEnd := date.AddDate(0, 0, 1)
+	if dayEnd.After(now) {
+		return nil, domain.ErrInvalidInterestDate
+	}
+
+	accounts, err := u.interestRepository.ListAccountsForAccrual(ctx, date)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar carteiras de poupança: %w", err)
+	}
+
+	output := &AccrueInterestOutput{}
+	var firstErr error
+	for i := range accounts {
+		created, err := u.accrue(ctx, &accounts[i], date, dayEnd)
+		switch {
+		case err != nil:
+			output.Failed++
+			if firstErr == nil {
+				firstErr = err
+			}
+		case created:
+			output.Accrued++
+		default:
+			output.AlreadyAccrued++
+		}
+	}
+
+	return output, firstErr
+}
+
+func (u *AccrueInterestUseCase) accrue(ctx context.Context, account *domain.InterestAccount, date, dayEnd time.Time) (bool, error) {
+	balance, err := u.entryRepository.BalanceAt(ctx, account.WalletID, dayEnd)
+	if err != nil {
+		return false, fmt.Errorf("falha ao calcular saldo de fim de dia (carteira %d): %w", account.WalletID, err)
+	}
+
+	created, err := u.interestRepository.CreateAccrual(ctx, &domain.InterestAccrual{
+		WalletID:   account.WalletID,
+		Date:       date,
+		Balance:    balance,
+		AnnualRate: account.AnnualRate,
+		DayCount:   account.DayCount,
+		Units:      account.DailyAccrual(balance, date),
+	})
+	if err != nil {
+		return false, fmt.Errorf("falha ao registrar rendimento (carteira %d): %w", account.WalletID, err)
+	}
+	return created, nil
+}
@@ -0,0 +1,51 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// CancelScheduledTransferUseCase cancela um agendamento que ainda não começou a ser executado
+type CancelScheduledTransferUseCase struct {
+	scheduleRepository gateway.ScheduledTransferRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewCancelScheduledTransfer(scheduleRepo gateway.ScheduledTransferRepository, txManager gateway.TransactionManager) *CancelScheduledTransferUseCase {
+	return &CancelScheduledTransferUseCase{
+		scheduleRepository: scheduleRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *CancelScheduledTransferUseCase) Execute(ctx context.Context, scheduleID string) (*ScheduledTransferOutput, error) {
+	var schedule *domain.ScheduledTransfer
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		scheduleRepoTx := u.scheduleRepository.WithTx(transactionObject)
+
+		// O UPDATE condicional (status = 'pending') decide a corrida com o scheduler
+		if err := scheduleRepoTx.Cancel(contextWithTx, scheduleID); err != nil {
+			if err == domain.ErrScheduleNotFound || err == domain.ErrScheduleNotPending {
+				return err
+			}
+			return fmt.Errorf("erro ao cancelar agendamento: %w", err)
+		}
+
+		var err error
+		if schedule, err = scheduleRepoTx.GetByID(contextWithTx, scheduleID); err != nil {
+			return fmt.Errorf("erro ao buscar agendamento: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toScheduledTransferOutput(schedule), nil
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// CancelStandingOrderUseCase encerra a ordem. O histórico das ocorrências é mantido.
+type CancelStandingOrderUseCase struct {
+	orderRepository    gateway.StandingOrderRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewCancelStandingOrder(orderRepo gateway.StandingOrderRepository, txManager gateway.TransactionManager) *CancelStandingOrderUseCase {
+	return &CancelStandingOrderUseCase{
+		orderRepository:    orderRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *CancelStandingOrderUseCase) Execute(ctx context.Context, orderID string) (*StandingOrderOutput, error) {
+	var order *domain.StandingOrder
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		orderRepoTx := u.orderRepository.WithTx(transactionObject)
+
+		var err error
+		order, err = orderRepoTx.GetByID(contextWithTx, orderID)
+		if err != nil {
+			if err == domain.ErrOrderNotFound {
+				return err
+			}
+			return fmt.Errorf("erro ao buscar ordem permanente: %w", err)
+		}
+		if order.Status != domain.StandingOrderStatusActive && order.Status != domain.StandingOrderStatusPaused {
+			return domain.ErrOrderNotActive
+		}
+
+		// Uma ocorrência já em execução termina normalmente; o executor não reativa a ordem
+		order.Status = domain.StandingOrderStatusCancelled
+		order.NextOccurrenceAt = nil
+		order.NextRunAt = nil
+		order.Attempts = 0
+
+		if err := orderRepoTx.Update(contextWithTx, order); err != nil {
+			return fmt.Errorf("erro ao cancelar ordem permanente: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toStandingOrderOutput(order), nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type CaptureHoldInput struct {
+	HoldID         string
+	Amount         domain.Money // Opcional: 0 captura o valor total. Captura parcial libera o restante.
+	IdempotencyKey *string
+	// Escopo (cliente e rota) da IdempotencyKey, ver gateway.IdempotencyKey.Scope
+	IdempotencyScope string
+}
+
+// CaptureHoldUseCase transforma a autorização em uma transferência de verdade (segunda fase).
+// A autorização é de captura única: o que não for capturado volta a ficar disponível.
+type CaptureHoldUseCase struct {
+	walletRepository   gateway.WalletRepository
+	holdRepository     gateway.HoldRepository
+	transferMoney      *TransferMoneyUseCase // Reaproveita a transferência dentro da mesma transação
+	transactionManager gateway.TransactionManager
+	eventPublisher     gateway.EventPublisher
+}
+
+func NewCaptureHold(
+	walletRepo gateway.WalletRepository,
+	holdRepo gateway.HoldRepository,
+	transferMoney *TransferMoneyUseCase,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *CaptureHoldUseCase {
+	return &CaptureHoldUseCase{
+		walletRepository:   walletRepo,
+		holdRepository:     holdRepo,
+		transferMoney:      transferMoney,
+		transactionManager: txManager,
+		eventPublisher:     publisher,
+	}
+}
+
+func (u *CaptureHoldUseCase) Execute(ctx context.Context, input CaptureHoldInput) (*HoldOutput, error) {
+	if input.Amount.Amount < 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
+	var hold *domain.Hold
+	var transaction *domain.Transaction
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+		holdRepoTx := u.holdRepository.WithTx(transactionObject)
+
+		// A autorização é travada antes das carteiras (mesma ordem do cancelamento e da expiração)
+		var err error
+		hold, err = holdRepoTx.GetByIDForUpdate(contextWithTx, input.HoldID)
+		if err != nil {
+			return err
+		}
+		if !hold.IsActive() {
+			return domain.ErrHoldNotActive
+		}
+		// Vencida mas ainda não varrida: não pode mais ser capturada
+		if hold.IsExpired(time.Now()) {
+			return domain.ErrHoldExpired
+		}
+
+		// Sem moeda, o valor está na moeda da autorização
+		captured, err := input.Amount.In(hold.Currency)
+		if err != nil {
+			return err
+		}
+		amount := captured.Amount
+		if amount == 0 {
+			amount = hold.Amount
+		}
+		if amount > hold.Amount {
+			return domain.ErrHoldAmountExceeded
+		}
+
+		// Trava as carteiras na ordem global antes de mexer na reserva;
+		// a transferência trava de novo, o que não tem efeito dentro da mesma transação.
+		if _, err := lockWallets(contextWithTx, walletRepoTx, hold.WalletID, hold.ToWalletID); err != nil {
+			return err
+		}
+
+		// Devolve a reserva inteira: o valor capturado sai do saldo pela transferência,
+		// e o restante de uma captura parcial volta a ficar disponível.
+		if err := walletRepoTx.Release(contextWithTx, hold.WalletID, hold.Amount); err != nil {
+			return err
+		}
+
+		transaction, err = u.transferMoney.transfer(contextWithTx, TransferMoneyInput{
+			FromWalletID:     hold.WalletID,
+			ToWalletID:       hold.ToWalletID,
+			Amount:           domain.Money{Amount: amount, Currency: hold.Currency},
+			IdempotencyKey:   input.IdempotencyKey,
+			IdempotencyScope: input.IdempotencyScope,
+		})
+		if err != nil {
+			return err
+		}
+
+		hold.Status = domain.HoldStatusCaptured
+		hold.CapturedAmount = amount
+		hold.TransactionID = transaction.ID
+		return holdRepoTx.Close(contextWithTx, hold)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if u.eventPublisher != nil {
+		event := transferEvent(TransferMoneyInput{
+			FromWalletID: hold.WalletID,
+			ToWalletID:   hold.ToWalletID,
+		}, transaction, transaction.Status)
+		_ = u.eventPublisher.Publish(ctx, "ledger_events", "transaction."+transaction.Status, event)
+	}
+	publishHoldEvent(ctx, u.eventPublisher, hold)
+
+	return toHoldOutput(hold), nil
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// CashMovementInput é a entrada de um depósito ou de um saque
+type CashMovementInput struct {
+	WalletID       int64
+	Amount         domain.Money // A moeda é opcional: vazia, vale a da carteira
+	Description    string       // Opcional (ex: identificador do Pix/TED de origem)
+	IdempotencyKey *string
+	// Escopo (cliente e rota) da IdempotencyKey, ver gateway.IdempotencyKey.Scope
+	IdempotencyScope string
+}
+
+type CashMovementOutput struct {
+	TransactionID string `json:"transaction_id"`
+	Status        string `json:"status"`
+	Balance       int64  `json:"balance"` // Saldo da carteira depois da operação
+}
+
+// cashMovement move dinheiro entre a carteira de um cliente e a carteira de liquidação da moeda.
+// É a única porta de entrada e saída de dinheiro do ledger: depósitos (kind deposit) e saques
+// (kind withdrawal) são transações comuns, com partidas que somam zero.
+type cashMovement struct {
+	kind                  string
+	walletRepository      gateway.WalletRepository
+	transactionRepository gateway.TransactionRepository
+	entryRepository       gateway.EntryRepository
+	limitRepository       gateway.LimitRepository // Só nos saques: a saída consome os limites de movimentação
+	transactionManager    gateway.TransactionManager
+	eventPublisher        gateway.EventPublisher
+}
+
+func (m *cashMovement) execute(ctx context.Context, input CashMovementInput) (*CashMovementOutput, error) {
+	var transaction *domain.Transaction
+	var balance int64
+	status := domain.TransactionStatusFailed
+
+	// Eventos com routing key própria: deposit.completed, withdrawal.failed...
+	defer func() {
+		if m.eventPublisher != nil {
+			_ = m.eventPublisher.Publish(ctx, "ledger_events", m.kind+"."+status, m.event(input, transaction, status))
+		}
+	}()
+
+	if input.Amount.Amount <= 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
+	err := m.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := m.walletRepository.WithTx(transactionObject)
+
+		// Só a carteira do cliente entra no lock: a liquidação é disputada por todos os depósitos
+		// e saques da moeda e é movimentada de forma atômica no fim (como a receita de tarifas)
+		wallet, err := walletRepoTx.GetByIDForUpdate(contextWithTx, input.WalletID)
+		if err != nil {
+			return err
+		}
+		if wallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+		amount, err := input.Amount.In(wallet.Currency)
+		if err != nil {
+			return err
+		}
+		if m.kind == domain.TransactionKindDeposit {
+			err = wallet.CanCredit()
+		} else {
+			err = wallet.CanDebit()
+		}
+		if err != nil {
+			return err
+		}
+		// Com a carteira travada, saídas concorrentes esperam: a soma das saídas não muda até o Commit
+		if m.kind == domain.TransactionKindWithdrawal {
+			if err := checkVelocityLimits(contextWithTx, m.limitRepository.WithTx(transactionObject), wallet.ID, amount.Amount, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		settlement, err := walletRepoTx.GetSystemWallet(contextWithTx, domain.SystemWalletSettlement, wallet.Currency)
+		if err != nil {
+			return fmt.Errorf("carteira de liquidação %s não configurada: %w", wallet.Currency, err)
+		}
+
+		transaction = &domain.Transaction{
+			Kind:             m.kind,
+			Amount:           amount.Amount,
+			Currency:         amount.Currency,
+			Status:           domain.TransactionStatusCompleted,
+			IdempotencyKey:   input.IdempotencyKey,
+			IdempotencyScope: input.IdempotencyScope,
+			Description:      input.Description,
+		}
+		// A carteira do cliente vem primeiro nas partidas: no saque, o débito falha por saldo
+		// insuficiente antes de a liquidação ser tocada
+		var entries []domain.Entry
+		if m.kind == domain.TransactionKindDeposit {
+			transaction.FromWalletID, transaction.ToWalletID = settlement.ID, wallet.ID
+			balance = wallet.Balance + amount.Amount
+			entries = []domain.Entry{
+				{WalletID: wallet.ID, Amount: amount.Amount, Currency: wallet.Currency},
+				{WalletID: settlement.ID, Amount: -amount.Amount, Currency: wallet.Currency},
+			}
+		} else {
+			transaction.FromWalletID, transaction.ToWalletID = wallet.ID, settlement.ID
+			balance = wallet.Balance - amount.Amount
+			entries = []domain.Entry{
+				{WalletID: wallet.ID, Amount: -amount.Amount, Currency: wallet.Currency},
+				{WalletID: settlement.ID, Amount: amount.Amount, Currency: wallet.Currency},
+			}
+		}
+
+		if err := m.transactionRepository.WithTx(transactionObject).Create(contextWithTx, transaction); err != nil {
+			if err == domain.ErrIdempotencyKey {
+				return err
+			}
+			return fmt.Errorf("falha ao salvar histórico da transação: %w", err)
+		}
+		for i := range entries {
+			entries[i].TransactionID = transaction.ID
+		}
+		return postEntries(contextWithTx, walletRepoTx, m.entryRepository.WithTx(transactionObject), entries)
+	})
+	if err != nil {
+		transaction = nil
+		return nil, err
+	}
+	status = transaction.Status
+
+	return &CashMovementOutput{
+		TransactionID: transaction.ID,
+		Status:        transaction.Status,
+		Balance:       balance,
+	}, nil
+}
+
+// event monta o payload publicado em ledger_events.
+// transaction é nil se a operação falhou: só a carteira do cliente é conhecida.
+func (m *cashMovement) event(input CashMovementInput, transaction *domain.Transaction, status string) map[string]interface{} {
+	event := map[string]interface{}{
+		"transaction_id": "",
+		"kind":           m.kind,
+		"amount":         input.Amount.Amount,
+		"currency":       input.Amount.Currency,
+		"description":    input.Description,
+		"status":         status,
+	}
+	if m.kind == domain.TransactionKindDeposit {
+		event["to_wallet"] = input.WalletID
+	} else {
+		event["from_wallet"] = input.WalletID
+	}
+
+	if transaction != nil {
+		event["transaction_id"] = transaction.ID
+		event["from_wallet"] = transaction.FromWalletID
+		event["to_wallet"] = transaction.ToWalletID
+		event["currency"] = transaction.Currency
+	}
+	return event
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Ações administrativas sobre o ciclo de vida da carteira
+const (
+	WalletActionFreeze   = "freeze"
+	WalletActionUnfreeze = "unfreeze"
+	WalletActionClose    = "close"
+)
+
+// walletActionEvents é o sufixo do evento publicado (wallet.<sufixo>) para cada ação
+var walletActionEvents = map[string]string{
+	WalletActionFreeze:   "frozen",
+	WalletActionUnfreeze: "unfrozen",
+	WalletActionClose:    "closed",
+}
+
+type ChangeWalletStatusInput struct {
+	WalletID int64
+	Action   string
+	Reason   string
+	// ChangedBy identifica o operador que fez a alteração (vai no evento)
+	ChangedBy string
+}
+
+type ChangeWalletStatusOutput struct {
+	WalletID       int64  `json:"wallet_id"`
+	PreviousStatus string `json:"previous_status"`
+	Status         string `json:"status"`
+}
+
+// ChangeWalletStatusUseCase congela, descongela ou encerra uma carteira de cliente.
+// Cada mudança publica um evento wallet.* em ledger_events.
+type ChangeWalletStatusUseCase struct {
+	walletRepository   gateway.WalletRepository
+	transactionManager gateway.TransactionManager
+	eventPublisher     gateway.EventPublisher
+}
+
+func NewChangeWalletStatus(
+	walletRepo gateway.WalletRepository,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *ChangeWalletStatusUseCase {
+	return &ChangeWalletStatusUseCase{
+		walletRepository:   walletRepo,
+		transactionManager: txManager,
+		eventPublisher:     publisher,
+	}
+}
+
+func (u *ChangeWalletStatusUseCase) Execute(ctx context.Context, input ChangeWalletStatusInput) (*ChangeWalletStatusOutput, error) {
+	eventName, ok := walletActionEvents[input.Action]
+	if !ok {
+		return nil, domain.ErrInvalidWalletTransition
+	}
+
+	var (
+		wallet         *domain.Wallet
+		previousStatus string
+	)
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+
+		// Trava a carteira: transferências em andamento terminam antes da mudança de status,
+		// e as próximas já enxergam o novo status
+		var err error
+		wallet, err = walletRepoTx.GetByIDForUpdate(contextWithTx, input.WalletID)
+		if err != nil {
+			return err
+		}
+		if wallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+
+		previousStatus = wallet.Status
+		switch input.Action {
+		case WalletActionFreeze:
+			err = wallet.Freeze()
+		case WalletActionUnfreeze:
+			err = wallet.Unfreeze()
+		case WalletActionClose:
+			err = wallet.Close()
+		}
+		if err != nil {
+			return err
+		}
+
+		return walletRepoTx.UpdateStatus(contextWithTx, wallet.ID, wallet.Status)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if u.eventPublisher != nil {
+		event := map[string]interface{}{
+			"wallet_id":       wallet.ID,
+			"previous_status": previousStatus,
+			"status":          wallet.Status,
+			"reason":          input.Reason,
+			"changed_by":      input.ChangedBy,
+		}
+		_ = u.eventPublisher.Publish(ctx, "ledger_events", "wallet."+eventName, event)
+	}
+
+	return &ChangeWalletStatusOutput{
+		WalletID:       wallet.ID,
+		PreviousStatus: previousStatus,
+		Status:         wallet.Status,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type CreateCustomerInput struct {
+	Name     string
+	Document string // CPF ou CNPJ, com ou sem pontuação
+	Email    string
+	Phone    string // Opcional
+}
+
+// CreateCustomerUseCase cadastra um cliente. O KYC começa pendente e só muda pela rota administrativa.
+type CreateCustomerUseCase struct {
+	customerRepository gateway.CustomerRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewCreateCustomer(customerRepo gateway.CustomerRepository, txManager gateway.TransactionManager) *CreateCustomerUseCase {
+	return &CreateCustomerUseCase{
+		customerRepository: customerRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *CreateCustomerUseCase) Execute(ctx context.Context, input CreateCustomerInput) (*CustomerOutput, error) {
+	customer, err := domain.NewCustomer(input.Name, input.Document, input.Email, input.Phone)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		if err := u.customerRepository.WithTx(transactionObject).Create(contextWithTx, customer); err != nil {
+			if err == domain.ErrDocumentAlreadyExists {
+				return err
+			}
+			return fmt.Errorf("erro ao cadastrar cliente: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toCustomerOutput(customer), nil
+}
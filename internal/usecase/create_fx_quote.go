@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type CreateFXQuoteInput struct {
+	SourceCurrency      string
+	DestinationCurrency string
+	SourceAmount        int64 // Na menor unidade da moeda de origem
+}
+
+type CreateFXQuoteOutput struct {
+	QuoteID             string `json:"quote_id"`
+	SourceCurrency      string `json:"source_currency"`
+	DestinationCurrency string `json:"destination_currency"`
+	Rate                string `json:"rate"`
+	SourceAmount        int64  `json:"source_amount"`
+	DestinationAmount   int64  `json:"destination_amount"`
+	ExpiresAt           string `json:"expires_at"`
+}
+
+// CreateFXQuoteUseCase trava uma cotação de câmbio por um TTL curto.
+// A transferência executada com o quote_id usa exatamente esses valores.
+type CreateFXQuoteUseCase struct {
+	rateProvider       gateway.FXRateProvider
+	fxQuoteRepository  gateway.FXQuoteRepository
+	transactionManager gateway.TransactionManager
+	ttl                time.Duration
+}
+
+func NewCreateFXQuote(rateProvider gateway.FXRateProvider, fxQuoteRepo gateway.FXQuoteRepository, txManager gateway.TransactionManager, ttl time.Duration) *CreateFXQuoteUseCase {
+	return &CreateFXQuoteUseCase{
+		rateProvider:       rateProvider,
+		fxQuoteRepository:  fxQuoteRepo,
+		transactionManager: txManager,
+		ttl:                ttl,
+	}
+}
+
+func (u *CreateFXQuoteUseCase) Execute(ctx context.Context, input CreateFXQuoteInput) (*CreateFXQuoteOutput, error) {
+	source, err := domain.ParseCurrency(input.SourceCurrency)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := domain.ParseCurrency(input.DestinationCurrency)
+	if err != nil {
+		return nil, err
+	}
+	if source == destination {
+		return nil, domain.ErrRateUnavailable
+	}
+	if input.SourceAmount <= 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
+	rate, err := u.rateProvider.GetRate(ctx, source, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	// Armazenamos a taxa com precisão fixa: o valor convertido é calculado
+	// com a MESMA taxa que fica gravada na transação.
+	appliedRate, err := domain.ParseRate(rate.Rate.FloatString(domain.RatePrecision))
+	if err != nil {
+		return nil, err
+	}
+	rate.Rate = appliedRate
+
+	destinationAmount, err := rate.Convert(input.SourceAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &domain.FXQuote{
+		SourceCurrency:      source,
+		DestinationCurrency: destination,
+		Rate:                appliedRate,
+		SourceAmount:        input.SourceAmount,
+		DestinationAmount:   destinationAmount,
+		ExpiresAt:           time.Now().Add(u.ttl),
+	}
+	err = u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		if err := u.fxQuoteRepository.WithTx(transactionObject).Create(contextWithTx, quote); err != nil {
+			return fmt.Errorf("erro ao salvar cotação: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateFXQuoteOutput{
+		QuoteID:             quote.ID,
+		SourceCurrency:      string(quote.SourceCurrency),
+		DestinationCurrency: string(quote.DestinationCurrency),
+		Rate:                quote.Rate.FloatString(domain.RatePrecision),
+		SourceAmount:        quote.SourceAmount,
+		DestinationAmount:   quote.DestinationAmount,
+		ExpiresAt:           quote.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
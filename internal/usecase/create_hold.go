@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type CreateHoldInput struct {
+	WalletID   int64        // Carteira que terá o saldo reservado
+	ToWalletID int64        // Carteira do lojista que recebe na captura
+	Amount     domain.Money // A moeda é opcional: vazia, vale a da carteira
+	ExpiresAt  *time.Time   // Opcional: padrão domain.DefaultHoldTTL
+}
+
+// CreateHoldUseCase reserva saldo de uma carteira (primeira fase do pagamento).
+// O dinheiro continua na carteira, mas deixa de estar disponível para outras operações.
+type CreateHoldUseCase struct {
+	walletRepository   gateway.WalletRepository
+	holdRepository     gateway.HoldRepository
+	transactionManager gateway.TransactionManager
+	eventPublisher     gateway.EventPublisher
+}
+
+func NewCreateHold(
+	walletRepo gateway.WalletRepository,
+	holdRepo gateway.HoldRepository,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *CreateHoldUseCase {
+	return &CreateHoldUseCase{
+		walletRepository:   walletRepo,
+		holdRepository:     holdRepo,
+		transactionManager: txManager,
+		eventPublisher:     publisher,
+	}
+}
+
+func (u *CreateHoldUseCase) Execute(ctx context.Context, input CreateHoldInput) (*HoldOutput, error) {
+	if input.Amount.Amount <= 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(domain.DefaultHoldTTL)
+	if input.ExpiresAt != nil {
+		if !input.ExpiresAt.After(now) || input.ExpiresAt.Sub(now) > domain.MaxHoldTTL {
+			return nil, domain.ErrInvalidExpiration
+		}
+		expiresAt = *input.ExpiresAt
+	}
+
+	hold := &domain.Hold{
+		WalletID:   input.WalletID,
+		ToWalletID: input.ToWalletID,
+		Amount:     input.Amount.Amount,
+		ExpiresAt:  expiresAt,
+	}
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+		holdRepoTx := u.holdRepository.WithTx(transactionObject)
+
+		if input.WalletID == input.ToWalletID {
+			return domain.ErrSameWallet
+		}
+		// Travadas para que o status checado abaixo não mude (congelamento) antes do Commit
+		wallets, err := lockWallets(contextWithTx, walletRepoTx, input.WalletID, input.ToWalletID)
+		if err != nil {
+			return err
+		}
+		wallet, toWallet := wallets[input.WalletID], wallets[input.ToWalletID]
+
+		if wallet.IsSystem() || toWallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+		if err := wallet.CanDebit(); err != nil {
+			return err
+		}
+		if err := toWallet.CanCredit(); err != nil {
+			return err
+		}
+		amount, err := input.Amount.In(wallet.Currency)
+		if err != nil {
+			return err
+		}
+		if toWallet.Currency != amount.Currency {
+			return domain.ErrCurrencyMismatch
+		}
+		hold.Currency = amount.Currency
+
+		// O UPDATE já valida o saldo disponível (balance - held_amount >= amount)
+		if err := walletRepoTx.Hold(contextWithTx, wallet.ID, hold.Amount); err != nil {
+			return err
+		}
+		if err := holdRepoTx.Create(contextWithTx, hold); err != nil {
+			return fmt.Errorf("falha ao registrar autorização: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishHoldEvent(ctx, u.eventPublisher, hold)
+
+	return toHoldOutput(hold), nil
+}
+
+// publishHoldEvent avisa o restante do sistema sobre mudanças de estado de uma autorização
+// (hold.active na criação, hold.captured, hold.voided e hold.expired depois).
+func publishHoldEvent(ctx context.Context, publisher gateway.EventPublisher, hold *domain.Hold) {
+	if publisher == nil {
+		return
+	}
+
+	event := map[string]interface{}{
+		"hold_id":         hold.ID,
+		"wallet_id":       hold.WalletID,
+		"to_wallet_id":    hold.ToWalletID,
+		"amount":          hold.Amount,
+		"captured_amount": hold.CapturedAmount,
+		"currency":        hold.Currency,
+		"status":          hold.Status,
+		"transaction_id":  hold.TransactionID,
+	}
+	_ = publisher.Publish(ctx, "ledger_events", "hold."+hold.Status, event)
+}
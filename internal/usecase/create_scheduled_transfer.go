@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type CreateScheduledTransferInput struct {
+	FromWalletID int64
+	ToWalletID   int64
+	Amount       domain.Money // A moeda é opcional: vazia, vale a da carteira de origem
+	ExecuteAt    time.Time
+}
+
+// CreateScheduledTransferUseCase guarda uma transferência para execução futura.
+// Nada é reservado: o saldo só é checado quando o scheduler executa.
+type CreateScheduledTransferUseCase struct {
+	walletRepository   gateway.WalletRepository
+	scheduleRepository gateway.ScheduledTransferRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewCreateScheduledTransfer(walletRepo gateway.WalletRepository, scheduleRepo gateway.ScheduledTransferRepository, txManager gateway.TransactionManager) *CreateScheduledTransferUseCase {
+	return &CreateScheduledTransferUseCase{
+		walletRepository:   walletRepo,
+		scheduleRepository: scheduleRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *CreateScheduledTransferUseCase) Execute(ctx context.Context, input CreateScheduledTransferInput) (*ScheduledTransferOutput, error) {
+	if input.Amount.Amount <= 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+	if !input.ExecuteAt.After(time.Now()) {
+		return nil, domain.ErrInvalidSchedule
+	}
+	if input.FromWalletID == input.ToWalletID {
+		return nil, domain.ErrSameWallet
+	}
+
+	var schedule *domain.ScheduledTransfer
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+
+		// Validações antecipadas: melhor recusar agora do que falhar na data agendada
+		fromWallet, err := walletRepoTx.GetByID(contextWithTx, input.FromWalletID)
+		if err != nil {
+			return err
+		}
+		toWallet, err := walletRepoTx.GetByID(contextWithTx, input.ToWalletID)
+		if err != nil {
+			return err
+		}
+		if fromWallet.IsSystem() || toWallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+		// Carteira congelada pode voltar a ficar ativa até a execução; encerrada, não
+		if fromWallet.Status == domain.WalletStatusClosed || toWallet.Status == domain.WalletStatusClosed {
+			return domain.ErrWalletClosed
+		}
+		// Sem câmbio: a cotação expiraria muito antes da execução
+		amount, err := input.Amount.In(fromWallet.Currency)
+		if err != nil {
+			return err
+		}
+		if toWallet.Currency != amount.Currency {
+			return domain.ErrCurrencyMismatch
+		}
+
+		schedule = &domain.ScheduledTransfer{
+			FromWalletID: input.FromWalletID,
+			ToWalletID:   input.ToWalletID,
+			Amount:       amount.Amount,
+			Currency:     amount.Currency,
+			ExecuteAt:    input.ExecuteAt,
+		}
+		if err := u.scheduleRepository.WithTx(transactionObject).Create(contextWithTx, schedule); err != nil {
+			return fmt.Errorf("erro ao salvar agendamento: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toScheduledTransferOutput(schedule), nil
+}
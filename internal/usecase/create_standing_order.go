@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Padrões da política de saldo insuficiente
+const (
+	defaultStandingOrderMaxRetries    = 3
+	defaultStandingOrderRetryInterval = time.Hour
+)
+
+// CreateStandingOrderInput aceita a recorrência de duas formas (mutuamente exclusivas):
+// RRule ("FREQ=MONTHLY;BYMONTHDAY=5") ou os campos estruturados (Frequency, DayOfMonth, Weekdays...).
+type CreateStandingOrderInput struct {
+	FromWalletID int64
+	ToWalletID   int64
+	Amount       domain.Money // A moeda é opcional: vazia, vale a da carteira de origem
+	StartAt      time.Time    // Início da série e horário das ocorrências (zero = agora)
+
+	RRule      string
+	Frequency  string     // daily, weekly ou monthly
+	Interval   int        // A cada N dias/semanas/meses
+	DayOfMonth int        // monthly: 1..31 ou -1 (último dia)
+	Weekdays   []string   // weekly: MO, TU, WE, TH, FR, SA, SU
+	Count      int        // Total de ocorrências (0 = sem limite)
+	Until      *time.Time // Última data possível
+
+	InsufficientFundsPolicy string // skip (padrão) ou retry
+	MaxRetries              *int32 // retry: tentativas extras por ocorrência (padrão 3)
+	RetryInterval           time.Duration
+}
+
+// CreateStandingOrderUseCase cadastra uma transferência recorrente.
+// Nada é reservado: cada ocorrência é executada pelo cmd/scheduler via TransferMoneyUseCase.
+type CreateStandingOrderUseCase struct {
+	walletRepository   gateway.WalletRepository
+	orderRepository    gateway.StandingOrderRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewCreateStandingOrder(walletRepo gateway.WalletRepository, orderRepo gateway.StandingOrderRepository, txManager gateway.TransactionManager) *CreateStandingOrderUseCase {
+	return &CreateStandingOrderUseCase{
+		walletRepository:   walletRepo,
+		orderRepository:    orderRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *CreateStandingOrderUseCase) Execute(ctx context.Context, input CreateStandingOrderInput) (*StandingOrderOutput, error) {
+	if input.Amount.Amount <= 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+	if input.FromWalletID == input.ToWalletID {
+		return nil, domain.ErrSameWallet
+	}
+
+	recurrence, err := buildRecurrence(input)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &domain.StandingOrder{
+		FromWalletID:            input.FromWalletID,
+		ToWalletID:              input.ToWalletID,
+		Amount:                  input.Amount.Amount,
+		Recurrence:              recurrence,
+		InsufficientFundsPolicy: input.InsufficientFundsPolicy,
+		MaxRetries:              defaultStandingOrderMaxRetries,
+		RetryInterval:           input.RetryInterval,
+		Status:                  domain.StandingOrderStatusActive,
+	}
+	if order.InsufficientFundsPolicy == "" {
+		order.InsufficientFundsPolicy = domain.InsufficientFundsSkip
+	}
+	if input.MaxRetries != nil {
+		order.MaxRetries = *input.MaxRetries
+	}
+	if order.RetryInterval == 0 {
+		order.RetryInterval = defaultStandingOrderRetryInterval
+	}
+	if err := order.ValidatePolicy(); err != nil {
+		return nil, err
+	}
+
+	// Guardadas em UTC; a regra é aplicada no fuso de negócio (Recurrence.Next), igual na criação e na execução
+	now := time.Now().UTC()
+	order.StartAt = input.StartAt.UTC()
+	if input.StartAt.IsZero() {
+		order.StartAt = now
+	}
+	// Início no passado só ancora a série (dia da semana, dia do mês): nada retroativo é executado
+	order.ScheduleFrom(now)
+	if !order.IsActive() {
+		return nil, fmt.Errorf("%w: no occurrences in the future", domain.ErrInvalidRecurrence)
+	}
+
+	err = u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+
+		// Validações antecipadas: melhor recusar agora do que falhar em cada ocorrência
+		fromWallet, err := walletRepoTx.GetByID(contextWithTx, input.FromWalletID)
+		if err != nil {
+			return err
+		}
+		toWallet, err := walletRepoTx.GetByID(contextWithTx, input.ToWalletID)
+		if err != nil {
+			return err
+		}
+		if fromWallet.IsSystem() || toWallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+		// Carteira congelada pode voltar a ficar ativa até a execução; encerrada, não
+		if fromWallet.Status == domain.WalletStatusClosed || toWallet.Status == domain.WalletStatusClosed {
+			return domain.ErrWalletClosed
+		}
+		// Sem câmbio: não há cotação válida para ocorrências futuras
+		amount, err := input.Amount.In(fromWallet.Currency)
+		if err != nil {
+			return err
+		}
+		if toWallet.Currency != amount.Currency {
+			return domain.ErrCurrencyMismatch
+		}
+		order.Currency = amount.Currency
+
+		if err := u.orderRepository.WithTx(transactionObject).Create(contextWithTx, order); err != nil {
+			return fmt.Errorf("erro ao salvar ordem permanente: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toStandingOrderOutput(order), nil
+}
+
+// buildRecurrence monta a regra a partir do RRULE ou dos campos estruturados
+func buildRecurrence(input CreateStandingOrderInput) (*domain.Recurrence, error) {
+	structured := input.Frequency != "" || input.Interval != 0 || input.DayOfMonth != 0 ||
+		len(input.Weekdays) > 0 || input.Count != 0 || input.Until != nil
+
+	var recurrence *domain.Recurrence
+	switch {
+	case input.RRule != "" && structured:
+		return nil, fmt.Errorf("%w: use either rrule or frequency fields", domain.ErrInvalidRecurrence)
+	case input.RRule != "":
+		var err error
+		if recurrence, err = domain.ParseRRule(input.RRule); err != nil {
+			return nil, err
+		}
+	default:
+		recurrence = &domain.Recurrence{
+			Frequency:  strings.ToUpper(input.Frequency),
+			Interval:   input.Interval,
+			ByMonthDay: input.DayOfMonth,
+			Count:      input.Count,
+			Until:      input.Until,
+		}
+		for _, code := range input.Weekdays {
+			day, err := domain.ParseWeekday(code)
+			if err != nil {
+				return nil, err
+			}
+			recurrence.ByDay = append(recurrence.ByDay, day)
+		}
+	}
+
+	if err := recurrence.Validate(); err != nil {
+		return nil, err
+	}
+	return recurrence, nil
+}
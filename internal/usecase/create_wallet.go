@@ -2,40 +2,77 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
 )
 
 type CreateWalletInput struct {
-	Balance int64
+	CustomerID int64  // Titular (obrigatório)
+	Currency   string // ISO 4217. Vazio = BRL
 }
 
 type CreateWalletOutput struct {
-	ID      int64
-	Balance int64
+	ID         int64
+	CustomerID int64
+	Balance    int64
+	Currency   string
 }
 
+// CreateWalletUseCase abre uma carteira sempre com saldo zero.
+// Dinheiro só entra por um depósito (DepositUseCase), que tem a contrapartida na carteira de liquidação;
+// um saldo inicial seria dinheiro criado do nada e quebraria o fechamento do ledger.
+// A carteira é criada pelo Unit of Work: com Idempotency-Key, ela e a resposta gravada são
+// efetivadas na mesma transação, e a retentativa de um POST /wallets não abre outra carteira.
 type CreateWalletUseCase struct {
-	walletRepo gateway.WalletRepository
+	walletRepo         gateway.WalletRepository
+	transactionManager gateway.TransactionManager
 }
 
-func NewCreateWallet(walletRepo gateway.WalletRepository) *CreateWalletUseCase {
+func NewCreateWallet(walletRepo gateway.WalletRepository, txManager gateway.TransactionManager) *CreateWalletUseCase {
 	return &CreateWalletUseCase{
-		walletRepo: walletRepo,
+		walletRepo:         walletRepo,
+		transactionManager: txManager,
 	}
 }
 
 func (uc *CreateWalletUseCase) Execute(ctx context.Context, input CreateWalletInput) (*CreateWalletOutput, error) {
-	// A criação de wallet é uma operação atômica simples (um insert),
-	// então não precisamos abrir uma transação complexa (Begin/Commit) aqui,
-	// a menos que tivéssemos que salvar eventos ou outras coisas juntas.
-	wallet, err := uc.walletRepo.Create(ctx, input.Balance)
+	if input.CustomerID <= 0 {
+		return nil, domain.ErrCustomerRequired
+	}
+
+	currency := domain.DefaultCurrency
+	if input.Currency != "" {
+		var err error
+		if currency, err = domain.ParseCurrency(input.Currency); err != nil {
+			return nil, err
+		}
+	}
+	balance, err := domain.NewMoney(0, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var wallet *domain.Wallet
+	err = uc.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		var err error
+		wallet, err = uc.walletRepo.WithTx(transactionObject).Create(contextWithTx, input.CustomerID, balance)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return &CreateWalletOutput{
-		ID:      wallet.ID,
-		Balance: wallet.Balance,
+		ID:         wallet.ID,
+		CustomerID: input.CustomerID,
+		Balance:    wallet.Balance,
+		Currency:   string(wallet.Currency),
 	}, nil
 }
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// DeleteCustomerUseCase remove um cliente sem carteiras.
+// Carteiras nunca são apagadas (o ledger aponta para elas), então um cliente que já teve carteira fica no cadastro.
+type DeleteCustomerUseCase struct {
+	customerRepository gateway.CustomerRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewDeleteCustomer(customerRepo gateway.CustomerRepository, txManager gateway.TransactionManager) *DeleteCustomerUseCase {
+	return &DeleteCustomerUseCase{
+		customerRepository: customerRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *DeleteCustomerUseCase) Execute(ctx context.Context, customerID int64) error {
+	return u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		if err := u.customerRepository.WithTx(transactionObject).Delete(contextWithTx, customerID); err != nil {
+			if err == domain.ErrCustomerNotFound || err == domain.ErrCustomerHasWallets {
+				return err
+			}
+			return fmt.Errorf("erro ao remover cliente: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type DeleteFeePolicyInput struct {
+	Tier     string
+	Currency string
+}
+
+// DeleteFeePolicyUseCase remove a política: as transferências da faixa na moeda deixam de ter tarifa
+type DeleteFeePolicyUseCase struct {
+	feePolicyRepository gateway.FeePolicyRepository
+	transactionManager  gateway.TransactionManager
+}
+
+func NewDeleteFeePolicy(feePolicyRepo gateway.FeePolicyRepository, txManager gateway.TransactionManager) *DeleteFeePolicyUseCase {
+	return &DeleteFeePolicyUseCase{
+		feePolicyRepository: feePolicyRepo,
+		transactionManager:  txManager,
+	}
+}
+
+func (u *DeleteFeePolicyUseCase) Execute(ctx context.Context, input DeleteFeePolicyInput) error {
+	currency, err := domain.ParseCurrency(input.Currency)
+	if err != nil {
+		return err
+	}
+
+	return u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		if err := u.feePolicyRepository.WithTx(transactionObject).Delete(contextWithTx, input.Tier, currency); err != nil {
+			if err == domain.ErrFeePolicyNotFound {
+				return err
+			}
+			return fmt.Errorf("erro ao remover política de tarifa: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// DepositUseCase credita dinheiro que entrou na instituição (cash-in: Pix, TED, boleto...)
+// na carteira do cliente, com a contrapartida na carteira de liquidação da moeda
+type DepositUseCase struct {
+	movement cashMovement
+}
+
+func NewDeposit(
+	walletRepo gateway.WalletRepository,
+	transactionRepo gateway.TransactionRepository,
+	entryRepo gateway.EntryRepository,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *DepositUseCase {
+	return &DepositUseCase{
+		movement: cashMovement{
+			kind:                  domain.TransactionKindDeposit,
+			walletRepository:      walletRepo,
+			transactionRepository: transactionRepo,
+			entryRepository:       entryRepo,
+			transactionManager:    txManager,
+			eventPublisher:        publisher,
+		},
+	}
+}
+
+func (u *DepositUseCase) Execute(ctx context.Context, input CashMovementInput) (*CashMovementOutput, error) {
+	return u.movement.execute(ctx, input)
+}
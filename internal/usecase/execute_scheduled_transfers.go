@@ -0,0 +1,194 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+const (
+	// executeScheduledTransfersBatchSize limita quantos agendamentos são reservados por ciclo
+	executeScheduledTransfersBatchSize = 50
+	// maxScheduledTransferAttempts limita as novas tentativas após erros de infraestrutura
+	maxScheduledTransferAttempts = 5
+	// scheduledTransferLease é o tempo após o qual um item em processing é considerado abandonado
+	scheduledTransferLease = 5 * time.Minute
+	// scheduledTransferRetryBackoff é a espera antes da segunda tentativa; dobra a cada nova falha
+	scheduledTransferRetryBackoff = time.Minute
+)
+
+// permanentTransferErrors são falhas de negócio: tentar de novo não muda o resultado,
+// então o agendamento falha na hora. Qualquer outro erro é tratado como transitório.
+var permanentTransferErrors = []error{
+	domain.ErrInsufficientFunds,
+	domain.ErrWalletNotFound,
+	domain.ErrInvalidAmount,
+	domain.ErrCurrencyMismatch,
+	domain.ErrSystemWallet,
+	domain.ErrWalletFrozen,
+	domain.ErrWalletClosed,
+	domain.ErrLimitExceeded,
+	domain.ErrIdempotencyKeyMismatch,
+}
+
+type ExecuteScheduledTransfersOutput struct {
+	Executed int
+	Failed   int
+	Retrying int
+}
+
+// ExecuteScheduledTransfersUseCase é o ciclo do scheduler: reserva os agendamentos vencidos
+// e executa cada um pelo TransferMoneyUseCase com a Idempotency-Key do agendamento.
+type ExecuteScheduledTransfersUseCase struct {
+	scheduleRepository    gateway.ScheduledTransferRepository
+	transactionRepository gateway.TransactionRepository
+	transferMoney         *TransferMoneyUseCase
+}
+
+func NewExecuteScheduledTransfers(
+	scheduleRepo gateway.ScheduledTransferRepository,
+	transactionRepo gateway.TransactionRepository,
+	transferMoney *TransferMoneyUseCase,
+) *ExecuteScheduledTransfersUseCase {
+	return &ExecuteScheduledTransfersUseCase{
+		scheduleRepository:    scheduleRepo,
+		transactionRepository: transactionRepo,
+		transferMoney:         transferMoney,
+	}
+}
+
+func (u *ExecuteScheduledTransfersUseCase) Execute(ctx context.Context, now time.Time) (*ExecuteScheduledTransfersOutput, error) {
+	schedules, err := u.scheduleRepository.ClaimDue(ctx, now, now.Add(-scheduledTransferLease), executeScheduledTransfersBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao reservar agendamentos vencidos: %w", err)
+	}
+
+	output := &ExecuteScheduledTransfersOutput{}
+	var firstErr error
+	for i := range schedules {
+		schedule := &schedules[i]
+		u.run(ctx, schedule)
+
+		if err := u.scheduleRepository.Finish(ctx, schedule); err != nil {
+			// Outro scheduler reservou o item depois do lease: o resultado dele é o que vale
+			if errors.Is(err, domain.ErrScheduleClaimLost) {
+				continue
+			}
+			// O item fica em processing e volta a ser reservado depois do lease
+			if firstErr == nil {
+				firstErr = fmt.Errorf("falha ao gravar resultado do agendamento %s: %w", schedule.ID, err)
+			}
+			continue
+		}
+
+		switch schedule.Status {
+		case domain.ScheduleStatusExecuted:
+			output.Executed++
+		case domain.ScheduleStatusFailed:
+			output.Failed++
+		default:
+			output.Retrying++
+		}
+	}
+
+	return output, firstErr
+}
+
+// run executa um agendamento e preenche o resultado (status, transação, motivo da falha)
+func (u *ExecuteScheduledTransfersUseCase) run(ctx context.Context, schedule *domain.ScheduledTransfer) {
+	key := schedule.IdempotencyKey()
+	input := TransferMoneyInput{
+		FromWalletID:     schedule.FromWalletID,
+		ToWalletID:       schedule.ToWalletID,
+		Amount:           domain.Money{Amount: schedule.Amount, Currency: schedule.Currency},
+		IdempotencyKey:   &key,
+		IdempotencyScope: domain.IdempotencyScopeInternal,
+	}
+
+	// Uma tentativa anterior pode ter transferido e caído antes de gravar o resultado
+	transaction, err := findJobTransaction(ctx, u.transactionRepository, input)
+	if err == nil {
+		markScheduleExecuted(schedule, transaction.ID)
+		return
+	}
+	if err != domain.ErrTransactionNotFound {
+		markScheduleError(schedule, err)
+		return
+	}
+
+	output, err := u.transferMoney.Execute(ctx, input)
+	switch {
+	case err == nil:
+		markScheduleExecuted(schedule, output.TransactionID)
+	case errors.Is(err, domain.ErrIdempotencyKey):
+		// Outro scheduler executou em paralelo: o resultado dele é o nosso
+		transaction, lookupErr := findJobTransaction(ctx, u.transactionRepository, input)
+		if lookupErr != nil {
+			markScheduleError(schedule, lookupErr)
+			return
+		}
+		markScheduleExecuted(schedule, transaction.ID)
+	default:
+		markScheduleError(schedule, err)
+	}
+}
+
+// findJobTransaction busca a transação que uma tentativa anterior do job gravou com a chave interna.
+// A chave só prova que a transferência aconteceu se as pernas forem as do job.
+func findJobTransaction(ctx context.Context, transactionRepository gateway.TransactionRepository, input TransferMoneyInput) (*domain.Transaction, error) {
+	transaction, err := transactionRepository.GetByIdempotencyKey(ctx, input.IdempotencyScope, *input.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if !transaction.Matches(input.FromWalletID, input.ToWalletID, input.Amount) {
+		return nil, domain.ErrIdempotencyKeyMismatch
+	}
+	return transaction, nil
+}
+
+func markScheduleExecuted(schedule *domain.ScheduledTransfer, transactionID string) {
+	executedAt := time.Now()
+	schedule.Status = domain.ScheduleStatusExecuted
+	schedule.TransactionID = transactionID
+	schedule.FailureReason = ""
+	schedule.ExecutedAt = &executedAt
+	schedule.NextAttemptAt = nil
+}
+
+// markScheduleError falha o agendamento de vez em erros de negócio e tenta de novo nos demais
+func markScheduleError(schedule *domain.ScheduledTransfer, err error) {
+	if isPermanentTransferError(err) {
+		schedule.Status = domain.ScheduleStatusFailed
+		schedule.FailureReason = err.Error()
+		schedule.NextAttemptAt = nil
+		return
+	}
+	markScheduleRetry(schedule, err)
+}
+
+// markScheduleRetry devolve o agendamento para pending com espera exponencial (1, 2, 4, 8 minutos...),
+// ou falha de vez se esgotou as tentativas
+func markScheduleRetry(schedule *domain.ScheduledTransfer, err error) {
+	schedule.FailureReason = err.Error()
+	if schedule.Attempts >= maxScheduledTransferAttempts {
+		schedule.Status = domain.ScheduleStatusFailed
+		schedule.NextAttemptAt = nil
+		return
+	}
+	nextAttemptAt := time.Now().Add(scheduledTransferRetryBackoff << (schedule.Attempts - 1))
+	schedule.Status = domain.ScheduleStatusPending
+	schedule.NextAttemptAt = &nextAttemptAt
+}
+
+func isPermanentTransferError(err error) bool {
+	for _, permanent := range permanentTransferErrors {
+		if errors.Is(err, permanent) {
+			return true
+		}
+	}
+	return false
+}
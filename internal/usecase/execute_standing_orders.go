@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+const (
+	// executeStandingOrdersBatchSize limita quantas ordens são reservadas por ciclo
+	executeStandingOrdersBatchSize = 50
+	// standingOrderLease é o tempo de reserva: se o scheduler cair, a ordem volta a ser executada depois dele
+	standingOrderLease = 5 * time.Minute
+)
+
+type ExecuteStandingOrdersOutput struct {
+	Executed int
+	Skipped  int
+	Failed   int
+	Retrying int
+}
+
+// ExecuteStandingOrdersUseCase é o ciclo do scheduler para ordens permanentes: reserva as ordens vencidas,
+// executa a ocorrência atual pelo TransferMoneyUseCase e agenda a próxima.
+// Cada ciclo executa no máximo uma ocorrência por ordem: ocorrências atrasadas (scheduler parado)
+// são colocadas em dia nos ciclos seguintes.
+type ExecuteStandingOrdersUseCase struct {
+	orderRepository       gateway.StandingOrderRepository
+	transactionRepository gateway.TransactionRepository
+	transferMoney         *TransferMoneyUseCase
+	transactionManager    gateway.TransactionManager
+}
+
+func NewExecuteStandingOrders(
+	orderRepo gateway.StandingOrderRepository,
+	transactionRepo gateway.TransactionRepository,
+	transferMoney *TransferMoneyUseCase,
+	txManager gateway.TransactionManager,
+) *ExecuteStandingOrdersUseCase {
+	return &ExecuteStandingOrdersUseCase{
+		orderRepository:       orderRepo,
+		transactionRepository: transactionRepo,
+		transferMoney:         transferMoney,
+		transactionManager:    txManager,
+	}
+}
+
+func (u *ExecuteStandingOrdersUseCase) Execute(ctx context.Context, now time.Time) (*ExecuteStandingOrdersOutput, error) {
+	orders, err := u.orderRepository.ClaimDue(ctx, now, now.Add(standingOrderLease), executeStandingOrdersBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao reservar ordens permanentes vencidas: %w", err)
+	}
+
+	output := &ExecuteStandingOrdersOutput{}
+	var firstErr error
+	for i := range orders {
+		order := &orders[i]
+		occurrence := u.run(ctx, order, now)
+
+		// Histórico e progresso da ordem são gravados juntos
+		err := u.transactionManager.Run(ctx, func(ctxTx context.Context) error {
+			tx := ctxTx.Value(gateway.TransactionKey)
+			repo := u.orderRepository.WithTx(tx)
+
+			if occurrence != nil {
+				if err := repo.CreateOccurrence(ctxTx, occurrence); err != nil {
+					return err
+				}
+			}
+			return repo.FinishRun(ctxTx, order)
+		})
+		if err != nil {
+			// A reserva vence e a ocorrência é reprocessada: a Idempotency-Key impede pagamento em dobro
+			if firstErr == nil {
+				firstErr = fmt.Errorf("falha ao gravar execução da ordem %s: %w", order.ID, err)
+			}
+			continue
+		}
+
+		switch {
+		case occurrence == nil:
+			output.Retrying++
+		case occurrence.Status == domain.OccurrenceStatusExecuted:
+			output.Executed++
+		case occurrence.Status == domain.OccurrenceStatusSkipped:
+			output.Skipped++
+		default:
+			output.Failed++
+		}
+	}
+
+	return output, firstErr
+}
+
+// run executa a ocorrência atual da ordem e atualiza o progresso dela.
+// Retorna nil quando a ocorrência fica para uma nova tentativa.
+func (u *ExecuteStandingOrdersUseCase) run(ctx context.Context, order *domain.StandingOrder, now time.Time) *domain.StandingOrderOccurrence {
+	if order.NextOccurrenceAt == nil {
+		order.Advance()
+		return nil
+	}
+	occurrence := &domain.StandingOrderOccurrence{
+		StandingOrderID: order.ID,
+		OccurrenceAt:    *order.NextOccurrenceAt,
+	}
+	key := order.IdempotencyKey(occurrence.OccurrenceAt)
+	input := TransferMoneyInput{
+		FromWalletID:     order.FromWalletID,
+		ToWalletID:       order.ToWalletID,
+		Amount:           domain.Money{Amount: order.Amount, Currency: order.Currency},
+		IdempotencyKey:   &key,
+		IdempotencyScope: domain.IdempotencyScopeInternal,
+	}
+
+	// Uma tentativa anterior pode ter transferido e caído antes de gravar o resultado
+	transaction, err := findJobTransaction(ctx, u.transactionRepository, input)
+	switch {
+	case err == nil:
+		return completeOccurrence(order, occurrence, domain.OccurrenceStatusExecuted, transaction.ID, "")
+	case errors.Is(err, domain.ErrIdempotencyKeyMismatch):
+		return completeOccurrence(order, occurrence, domain.OccurrenceStatusFailed, "", err.Error())
+	case err != domain.ErrTransactionNotFound:
+		return retryOccurrence(order, err, now)
+	}
+
+	output, err := u.transferMoney.Execute(ctx, input)
+	switch {
+	case err == nil:
+		return completeOccurrence(order, occurrence, domain.OccurrenceStatusExecuted, output.TransactionID, "")
+	case errors.Is(err, domain.ErrIdempotencyKey):
+		// Outro scheduler executou em paralelo: o resultado dele é o nosso
+		transaction, lookupErr := findJobTransaction(ctx, u.transactionRepository, input)
+		if errors.Is(lookupErr, domain.ErrIdempotencyKeyMismatch) {
+			return completeOccurrence(order, occurrence, domain.OccurrenceStatusFailed, "", lookupErr.Error())
+		}
+		if lookupErr != nil {
+			return retryOccurrence(order, lookupErr, now)
+		}
+		return completeOccurrence(order, occurrence, domain.OccurrenceStatusExecuted, transaction.ID, "")
+	case errors.Is(err, domain.ErrInsufficientFunds):
+		if order.InsufficientFundsPolicy == domain.InsufficientFundsSkip {
+			return completeOccurrence(order, occurrence, domain.OccurrenceStatusSkipped, "", err.Error())
+		}
+		if order.Attempts < order.MaxRetries {
+			order.Attempts++
+			order.LastFailureReason = err.Error()
+			nextRun := now.Add(order.RetryInterval)
+			order.NextRunAt = &nextRun
+			return nil
+		}
+		return completeOccurrence(order, occurrence, domain.OccurrenceStatusFailed, "", err.Error())
+	case isPermanentTransferError(err):
+		return completeOccurrence(order, occurrence, domain.OccurrenceStatusFailed, "", err.Error())
+	default:
+		return retryOccurrence(order, err, now)
+	}
+}
+
+// completeOccurrence registra o resultado da ocorrência e avança a ordem para a próxima data
+func completeOccurrence(order *domain.StandingOrder, occurrence *domain.StandingOrderOccurrence, status, transactionID, reason string) *domain.StandingOrderOccurrence {
+	occurrence.Status = status
+	occurrence.TransactionID = transactionID
+	occurrence.FailureReason = reason
+
+	order.LastFailureReason = reason
+	order.Advance()
+	return occurrence
+}
+
+// retryOccurrence trata erros de infraestrutura: tenta de novo no próximo ciclo,
+// até maxScheduledTransferAttempts (o mesmo limite das transferências agendadas)
+func retryOccurrence(order *domain.StandingOrder, err error, now time.Time) *domain.StandingOrderOccurrence {
+	order.Attempts++
+	order.LastFailureReason = err.Error()
+	if order.Attempts >= maxScheduledTransferAttempts {
+		return completeOccurrence(order, &domain.StandingOrderOccurrence{
+			StandingOrderID: order.ID,
+			OccurrenceAt:    *order.NextOccurrenceAt,
+		}, domain.OccurrenceStatusFailed, "", err.Error())
+	}
+	order.NextRunAt = &now
+	return nil
+}
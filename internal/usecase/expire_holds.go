@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// expireHoldsBatchSize limita quantas autorizações são expiradas por execução
+const expireHoldsBatchSize = 100
+
+type ExpireHoldsOutput struct {
+	Expired int
+	Failed  int
+}
+
+// ExpireHoldsUseCase varre as autorizações vencidas e devolve o saldo reservado.
+// Cada autorização expira na sua própria transação: uma falha não trava as demais.
+type ExpireHoldsUseCase struct {
+	holdRepository gateway.HoldRepository
+	voidHold       *VoidHoldUseCase
+}
+
+func NewExpireHolds(holdRepo gateway.HoldRepository, voidHold *VoidHoldUseCase) *ExpireHoldsUseCase {
+	return &ExpireHoldsUseCase{
+		holdRepository: holdRepo,
+		voidHold:       voidHold,
+	}
+}
+
+func (u *ExpireHoldsUseCase) Execute(ctx context.Context, now time.Time) (*ExpireHoldsOutput, error) {
+	ids, err := u.holdRepository.ListExpired(ctx, now, expireHoldsBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar autorizações vencidas: %w", err)
+	}
+
+	output := &ExpireHoldsOutput{}
+	var firstErr error
+	for _, id := range ids {
+		_, err := u.voidHold.release(ctx, id, domain.HoldStatusExpired)
+		switch {
+		case err == nil:
+			output.Expired++
+		case errors.Is(err, domain.ErrHoldNotActive):
+			// Capturada ou cancelada entre a listagem e o lock: nada a fazer
+		default:
+			output.Failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("falha ao expirar autorização %s: %w", id, err)
+			}
+		}
+	}
+
+	return output, firstErr
+}
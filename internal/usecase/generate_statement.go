@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// maxStatementDays limita o período de um extrato (um ano, contando o bissexto)
+const maxStatementDays = 366
+
+// StatementKindOpening marca as linhas de saldo de abertura antigas (partidas sem transação)
+const StatementKindOpening = "opening"
+
+type GenerateStatementInput struct {
+	WalletID int64
+	From     *time.Time // Dia de Brasília. Opcional: padrão primeiro dia do mês passado
+	To       *time.Time // Dia de Brasília (inclusive). Opcional: padrão último dia do mês de From
+}
+
+type StatementLineOutput struct {
+	EntryID       int64  `json:"entry_id"`
+	TransactionID string `json:"transaction_id,omitempty"` // Ausente nos saldos de abertura antigos
+	Kind          string `json:"kind"`                     // transfer, journal, deposit, withdrawal ou opening
+	Description   string `json:"description"`              // Ex: "Transferência enviada", "Depósito", "Estorno"
+	Memo          string `json:"memo,omitempty"`           // Descrição informada na transação (ex: identificador do Pix)
+	// Outra carteira da transferência (destino numa saída, origem numa entrada)
+	CounterpartyWalletID int64  `json:"counterparty_wallet_id,omitempty"`
+	Amount               int64  `json:"amount"`               // Assinado: negativo = saída, positivo = entrada
+	FeeAmount            int64  `json:"fee_amount,omitempty"` // Tarifa (já incluída em amount)
+	Balance              int64  `json:"balance"`              // Saldo corrido depois da linha
+	PostedAt             string `json:"posted_at"`
+}
+
+// StatementOutput é o extrato do período: saldo de abertura, cada partida com o saldo corrido e
+// saldo de fechamento. Valores na menor unidade da moeda.
+type StatementOutput struct {
+	WalletID       int64                 `json:"wallet_id"`
+	CustomerID     *int64                `json:"customer_id,omitempty"`
+	Currency       string                `json:"currency"`
+	From           string                `json:"from"`
+	To             string                `json:"to"`
+	GeneratedAt    string                `json:"generated_at"`
+	OpeningBalance int64                 `json:"opening_balance"` // Saldo no início de 'from'
+	TotalCredits   int64                 `json:"total_credits"`
+	TotalDebits    int64                 `json:"total_debits"` // Negativo
+	ClosingBalance int64                 `json:"closing_balance"`
+	Lines          []StatementLineOutput `json:"lines"`
+
+	// Usados pelos formatos de arquivo (CSV/OFX), que precisam dos instantes exatos do período
+	PeriodStart time.Time `json:"-"`
+	PeriodEnd   time.Time `json:"-"` // Exclusivo: meia-noite seguinte a 'to' (ou agora, se 'to' é hoje)
+}
+
+// GenerateStatementUseCase monta o extrato de um período a partir do ledger.
+// O saldo de abertura parte da fotografia de fim de dia mais próxima (veja GetWalletBalanceUseCase).
+type GenerateStatementUseCase struct {
+	walletRepository          gateway.WalletRepository
+	transactionRepository     gateway.TransactionRepository
+	entryRepository           gateway.EntryRepository
+	balanceSnapshotRepository gateway.BalanceSnapshotRepository
+}
+
+func NewGenerateStatement(
+	walletRepo gateway.WalletRepository,
+	transactionRepo gateway.TransactionRepository,
+	entryRepo gateway.EntryRepository,
+	snapshotRepo gateway.BalanceSnapshotRepository,
+) *GenerateStatementUseCase {
+	return &GenerateStatementUseCase{
+		walletRepository:          walletRepo,
+		transactionRepository:     transactionRepo,
+		entryRepository:           entryRepo,
+		balanceSnapshotRepository: snapshotRepo,
+	}
+}
+
+func (u *GenerateStatementUseCase) Execute(ctx context.Context, input GenerateStatementInput) (*StatementOutput, error) {
+	now := time.Now()
+	today := domain.BusinessDate(now)
+
+	// Padrão: o mês passado inteiro (o extrato mensal)
+	from := time.Date(today.Year(), today.Month()-1, 1, 0, 0, 0, 0, domain.BusinessLocation)
+	if input.From != nil {
+		from = domain.BusinessDate(*input.From)
+	}
+	to := time.Date(from.Year(), from.Month()+1, 0, 0, 0, 0, 0, domain.BusinessLocation)
+	if input.To != nil {
+		to = domain.BusinessDate(*input.To)
+	} else if to.After(today) {
+		to = today
+	}
+	if to.After(today) || from.After(to) || to.After(from.AddDate(0, 0, maxStatementDays-1)) {
+		return nil, domain.ErrInvalidDateRange
+	}
+
+	wallet, err := u.walletRepository.GetByID(ctx, input.WalletID)
+	if err != nil {
+		if err == domain.ErrWalletNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
+	}
+
+	end := to.AddDate(0, 0, 1)
+	if end.After(now) {
+		end = now
+	}
+
+	opening, _, err := balanceBefore(ctx, u.entryRepository, u.balanceSnapshotRepository, wallet.ID, from)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := u.transactionRepository.ListStatement(ctx, wallet.ID, from, end)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar partidas do extrato: %w", err)
+	}
+
+	output := &StatementOutput{
+		WalletID:       wallet.ID,
+		CustomerID:     wallet.CustomerID,
+		Currency:       string(wallet.Currency),
+		From:           from.Format("2006-01-02"),
+		To:             to.Format("2006-01-02"),
+		GeneratedAt:    now.Format(time.RFC3339),
+		OpeningBalance: opening,
+		Lines:          make([]StatementLineOutput, 0, len(rows)),
+		PeriodStart:    from,
+		PeriodEnd:      end,
+	}
+	balance := opening
+	for i := range rows {
+		row := &rows[i]
+		balance += row.SignedAmount
+		if row.SignedAmount > 0 {
+			output.TotalCredits += row.SignedAmount
+		} else {
+			output.TotalDebits += row.SignedAmount
+		}
+		output.Lines = append(output.Lines, toStatementLine(row, balance))
+	}
+	output.ClosingBalance = balance
+
+	return output, nil
+}
+
+func toStatementLine(row *domain.WalletTransaction, balance int64) StatementLineOutput {
+	line := StatementLineOutput{
+		EntryID:       row.EntryID,
+		TransactionID: row.ID,
+		Kind:          row.Kind,
+		Memo:          row.Description,
+		Amount:        row.SignedAmount,
+		Balance:       balance,
+		PostedAt:      row.CreatedAt.Format(time.RFC3339),
+	}
+
+	switch {
+	case row.ID == "":
+		line.Kind = StatementKindOpening
+		line.Description = "Saldo de abertura"
+	case row.IsRefund():
+		line.Description = "Estorno"
+	case row.Kind == domain.TransactionKindDeposit:
+		line.Description = "Depósito"
+	case row.Kind == domain.TransactionKindWithdrawal:
+		line.Description = "Saque"
+	case row.IsJournal():
+		line.Description = "Lançamento contábil"
+	case row.Direction() == domain.DirectionOut:
+		line.Description = "Transferência enviada"
+	default:
+		line.Description = "Transferência recebida"
+	}
+
+	if row.ID != "" && row.IsTransfer() {
+		if row.Direction() == domain.DirectionOut {
+			line.CounterpartyWalletID = row.ToWalletID
+			// A tarifa só é cobrada do remetente
+			line.FeeAmount = row.FeeAmount
+		} else {
+			line.CounterpartyWalletID = row.FromWalletID
+		}
+	}
+	return line
+}
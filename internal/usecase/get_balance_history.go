@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+const (
+	defaultBalanceHistoryDays = 30
+	maxBalanceHistoryDays     = 366
+)
+
+type GetBalanceHistoryInput struct {
+	WalletID int64
+	From     *time.Time // Dia de Brasília. Opcional: padrão 30 dias antes de To
+	To       *time.Time // Dia de Brasília. Opcional: padrão hoje
+}
+
+type BalanceHistoryDayOutput struct {
+	Date      string `json:"date"`
+	NetChange int64  `json:"net_change"` // Movimento líquido do dia
+	Balance   int64  `json:"balance"`    // Saldo de fim de dia (hoje: saldo até agora)
+}
+
+type BalanceHistoryOutput struct {
+	WalletID       int64                     `json:"wallet_id"`
+	Currency       string                    `json:"currency"`
+	From           string                    `json:"from"`
+	To             string                    `json:"to"`
+	OpeningBalance int64                     `json:"opening_balance"` // Saldo no início de 'from'
+	Days           []BalanceHistoryDayOutput `json:"days"`            // Um item por dia, inclusive os sem movimento
+}
+
+// GetBalanceHistoryUseCase monta a série diária de saldos de fim de dia (calendário de Brasília)
+type GetBalanceHistoryUseCase struct {
+	walletRepository          gateway.WalletRepository
+	entryRepository           gateway.EntryRepository
+	balanceSnapshotRepository gateway.BalanceSnapshotRepository
+}
+
+func NewGetBalanceHistory(
+	walletRepo gateway.WalletRepository,
+	entryRepo gateway.EntryRepository,
+	snapshotRepo gateway.BalanceSnapshotRepository,
+) *GetBalanceHistoryUseCase {
+	return &GetBalanceHistoryUseCase{
+		walletRepository:          walletRepo,
+		entryRepository:           entryRepo,
+		balanceSnapshotRepository: snapshotRepo,
+	}
+}
+
+func (u *GetBalanceHistoryUseCase) Execute(ctx context.Context, input GetBalanceHistoryInput) (*BalanceHistoryOutput, error) {
+	today := domain.BusinessDate(time.Now())
+	to := today
+	if input.To != nil {
+		to = domain.BusinessDate(*input.To)
+	}
+	from := to.AddDate(0, 0, -(defaultBalanceHistoryDays - 1))
+	if input.From != nil {
+		from = domain.BusinessDate(*input.From)
+	}
+	if to.After(today) || from.After(to) || to.After(from.AddDate(0, 0, maxBalanceHistoryDays-1)) {
+		return nil, domain.ErrInvalidDateRange
+	}
+
+	wallet, err := u.walletRepository.GetByID(ctx, input.WalletID)
+	if err != nil {
+		if err == domain.ErrWalletNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
+	}
+
+	// Saldo de abertura pela fotografia; os dias do intervalo somam só as partidas deles
+	opening, _, err := balanceBefore(ctx, u.entryRepository, u.balanceSnapshotRepository, wallet.ID, from)
+	if err != nil {
+		return nil, err
+	}
+	end := to.AddDate(0, 0, 1)
+	movements, err := u.entryRepository.DailyNetAmounts(ctx, wallet.ID, from, end)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao somar partidas por dia: %w", err)
+	}
+
+	output := &BalanceHistoryOutput{
+		WalletID:       wallet.ID,
+		Currency:       string(wallet.Currency),
+		From:           from.Format("2006-01-02"),
+		To:             to.Format("2006-01-02"),
+		OpeningBalance: opening,
+		Days:           make([]BalanceHistoryDayOutput, 0, maxBalanceHistoryDays),
+	}
+	balance := opening
+	next := 0
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		var netChange int64
+		if next < len(movements) && movements[next].Date.Equal(day) {
+			netChange = movements[next].Amount
+			next++
+		}
+		balance += netChange
+		output.Days = append(output.Days, BalanceHistoryDayOutput{
+			Date:      day.Format("2006-01-02"),
+			NetChange: netChange,
+			Balance:   balance,
+		})
+	}
+	return output, nil
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// CustomerOutput é a representação de um cliente devolvida pelos usecases de clientes
+type CustomerOutput struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	DocumentType string `json:"document_type"` // cpf ou cnpj
+	Document     string `json:"document"`      // Sem pontuação
+	Email        string `json:"email"`
+	Phone        string `json:"phone,omitempty"`
+	KYCStatus    string `json:"kyc_status"`
+	CreatedAt    string `json:"created_at"`
+	UpdatedAt    string `json:"updated_at"`
+}
+
+type GetCustomerUseCase struct {
+	customerRepository gateway.CustomerRepository
+}
+
+func NewGetCustomer(customerRepo gateway.CustomerRepository) *GetCustomerUseCase {
+	return &GetCustomerUseCase{
+		customerRepository: customerRepo,
+	}
+}
+
+func (u *GetCustomerUseCase) Execute(ctx context.Context, customerID int64) (*CustomerOutput, error) {
+	customer, err := u.customerRepository.GetByID(ctx, customerID)
+	if err != nil {
+		if err == domain.ErrCustomerNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar cliente: %w", err)
+	}
+	return toCustomerOutput(customer), nil
+}
+
+func toCustomerOutput(customer *domain.Customer) *CustomerOutput {
+	return &CustomerOutput{
+		ID:           customer.ID,
+		Name:         customer.Name,
+		DocumentType: customer.DocumentType,
+		Document:     customer.Document,
+		Email:        customer.Email,
+		Phone:        customer.Phone,
+		KYCStatus:    customer.KYCStatus,
+		CreatedAt:    customer.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    customer.UpdatedAt.Format(time.RFC3339),
+	}
+}
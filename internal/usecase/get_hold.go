@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// HoldOutput é a representação de uma autorização devolvida por todos os usecases de holds
+type HoldOutput struct {
+	HoldID         string `json:"hold_id"`
+	WalletID       int64  `json:"wallet_id"`
+	ToWalletID     int64  `json:"to_wallet_id"`
+	Amount         int64  `json:"amount"`
+	CapturedAmount int64  `json:"captured_amount"`
+	Currency       string `json:"currency"`
+	Status         string `json:"status"`
+	TransactionID  string `json:"transaction_id,omitempty"` // Preenchido após a captura
+	ExpiresAt      string `json:"expires_at"`
+	CreatedAt      string `json:"created_at"`
+}
+
+func toHoldOutput(hold *domain.Hold) *HoldOutput {
+	return &HoldOutput{
+		HoldID:         hold.ID,
+		WalletID:       hold.WalletID,
+		ToWalletID:     hold.ToWalletID,
+		Amount:         hold.Amount,
+		CapturedAmount: hold.CapturedAmount,
+		Currency:       string(hold.Currency),
+		Status:         hold.Status,
+		TransactionID:  hold.TransactionID,
+		ExpiresAt:      hold.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:      hold.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+type GetHoldUseCase struct {
+	holdRepository gateway.HoldRepository
+}
+
+func NewGetHold(holdRepo gateway.HoldRepository) *GetHoldUseCase {
+	return &GetHoldUseCase{
+		holdRepository: holdRepo,
+	}
+}
+
+func (u *GetHoldUseCase) Execute(ctx context.Context, holdID string) (*HoldOutput, error) {
+	hold, err := u.holdRepository.GetByID(ctx, holdID)
+	if err != nil {
+		if err == domain.ErrHoldNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar autorização: %w", err)
+	}
+	return toHoldOutput(hold), nil
+}
@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// ScheduledTransferOutput é a representação de um agendamento devolvida pelos usecases de agendamento
+type ScheduledTransferOutput struct {
+	ScheduleID    string `json:"schedule_id"`
+	FromWalletID  int64  `json:"from_wallet_id"`
+	ToWalletID    int64  `json:"to_wallet_id"`
+	Amount        int64  `json:"amount"`
+	Currency      string `json:"currency"`
+	ExecuteAt     string `json:"execute_at"`
+	Status        string `json:"status"`
+	Attempts      int32  `json:"attempts"`
+	TransactionID string `json:"transaction_id,omitempty"` // Preenchido após a execução
+	FailureReason string `json:"failure_reason,omitempty"`
+	ExecutedAt    string `json:"executed_at,omitempty"`
+	NextAttemptAt string `json:"next_attempt_at,omitempty"` // Pendente aguardando nova tentativa
+	CreatedAt     string `json:"created_at"`
+}
+
+func toScheduledTransferOutput(schedule *domain.ScheduledTransfer) *ScheduledTransferOutput {
+	output := &ScheduledTransferOutput{
+		ScheduleID:    schedule.ID,
+		FromWalletID:  schedule.FromWalletID,
+		ToWalletID:    schedule.ToWalletID,
+		Amount:        schedule.Amount,
+		Currency:      string(schedule.Currency),
+		ExecuteAt:     schedule.ExecuteAt.Format(time.RFC3339),
+		Status:        schedule.Status,
+		Attempts:      schedule.Attempts,
+		TransactionID: schedule.TransactionID,
+		FailureReason: schedule.FailureReason,
+		CreatedAt:     schedule.CreatedAt.Format(time.RFC3339),
+	}
+	if schedule.ExecutedAt != nil {
+		output.ExecutedAt = schedule.ExecutedAt.Format(time.RFC3339)
+	}
+	if schedule.NextAttemptAt != nil {
+		output.NextAttemptAt = schedule.NextAttemptAt.Format(time.RFC3339)
+	}
+	return output
+}
+
+type GetScheduledTransferUseCase struct {
+	scheduleRepository gateway.ScheduledTransferRepository
+}
+
+func NewGetScheduledTransfer(scheduleRepo gateway.ScheduledTransferRepository) *GetScheduledTransferUseCase {
+	return &GetScheduledTransferUseCase{
+		scheduleRepository: scheduleRepo,
+	}
+}
+
+func (u *GetScheduledTransferUseCase) Execute(ctx context.Context, scheduleID string) (*ScheduledTransferOutput, error) {
+	schedule, err := u.scheduleRepository.GetByID(ctx, scheduleID)
+	if err != nil {
+		if err == domain.ErrScheduleNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar agendamento: %w", err)
+	}
+	return toScheduledTransferOutput(schedule), nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Quantas ocorrências recentes acompanham a consulta de uma ordem
+const standingOrderOccurrencesPerPage = 20
+
+// StandingOrderOutput é a representação de uma ordem permanente devolvida pelos usecases de ordens
+type StandingOrderOutput struct {
+	OrderID                 string `json:"order_id"`
+	FromWalletID            int64  `json:"from_wallet_id"`
+	ToWalletID              int64  `json:"to_wallet_id"`
+	Amount                  int64  `json:"amount"`
+	Currency                string `json:"currency"`
+	RRule                   string `json:"rrule"`
+	StartAt                 string `json:"start_at"`
+	InsufficientFundsPolicy string `json:"insufficient_funds_policy"`
+	MaxRetries              int32  `json:"max_retries"`
+	RetryIntervalSeconds    int64  `json:"retry_interval_seconds"`
+	Status                  string `json:"status"`
+	OccurrencesCount        int32  `json:"occurrences_count"`
+	NextOccurrenceAt        string `json:"next_occurrence_at,omitempty"` // Vazio quando a ordem terminou
+	NextRunAt               string `json:"next_run_at,omitempty"`        // Difere da ocorrência durante retries
+	Attempts                int32  `json:"attempts"`
+	LastFailureReason       string `json:"last_failure_reason,omitempty"`
+	CreatedAt               string `json:"created_at"`
+
+	Occurrences []StandingOrderOccurrenceOutput `json:"occurrences,omitempty"` // Só na consulta individual
+}
+
+type StandingOrderOccurrenceOutput struct {
+	OccurrenceAt  string `json:"occurrence_at"`
+	Status        string `json:"status"`
+	TransactionID string `json:"transaction_id,omitempty"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	ProcessedAt   string `json:"processed_at"`
+}
+
+func toStandingOrderOutput(order *domain.StandingOrder) *StandingOrderOutput {
+	output := &StandingOrderOutput{
+		OrderID:                 order.ID,
+		FromWalletID:            order.FromWalletID,
+		ToWalletID:              order.ToWalletID,
+		Amount:                  order.Amount,
+		Currency:                string(order.Currency),
+		RRule:                   order.Recurrence.String(),
+		StartAt:                 order.StartAt.Format(time.RFC3339),
+		InsufficientFundsPolicy: order.InsufficientFundsPolicy,
+		MaxRetries:              order.MaxRetries,
+		RetryIntervalSeconds:    int64(order.RetryInterval / time.Second),
+		Status:                  order.Status,
+		OccurrencesCount:        order.OccurrencesCount,
+		Attempts:                order.Attempts,
+		LastFailureReason:       order.LastFailureReason,
+		CreatedAt:               order.CreatedAt.Format(time.RFC3339),
+	}
+	if order.NextOccurrenceAt != nil {
+		output.NextOccurrenceAt = order.NextOccurrenceAt.Format(time.RFC3339)
+	}
+	if order.NextRunAt != nil {
+		output.NextRunAt = order.NextRunAt.Format(time.RFC3339)
+	}
+	return output
+}
+
+type GetStandingOrderUseCase struct {
+	orderRepository gateway.StandingOrderRepository
+}
+
+func NewGetStandingOrder(orderRepo gateway.StandingOrderRepository) *GetStandingOrderUseCase {
+	return &GetStandingOrderUseCase{
+		orderRepository: orderRepo,
+	}
+}
+
+func (u *GetStandingOrderUseCase) Execute(ctx context.Context, orderID string) (*StandingOrderOutput, error) {
+	order, err := u.orderRepository.GetByID(ctx, orderID)
+	if err != nil {
+		if err == domain.ErrOrderNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar ordem permanente: %w", err)
+	}
+
+	occurrences, err := u.orderRepository.ListOccurrences(ctx, order.ID, standingOrderOccurrencesPerPage)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar ocorrências da ordem: %w", err)
+	}
+
+	output := toStandingOrderOutput(order)
+	output.Occurrences = make([]StandingOrderOccurrenceOutput, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		output.Occurrences = append(output.Occurrences, StandingOrderOccurrenceOutput{
+			OccurrenceAt:  occurrence.OccurrenceAt.Format(time.RFC3339),
+			Status:        occurrence.Status,
+			TransactionID: occurrence.TransactionID,
+			FailureReason: occurrence.FailureReason,
+			ProcessedAt:   occurrence.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return output, nil
+}
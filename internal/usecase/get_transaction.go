@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// GetTransactionInput aceita o ID da transação OU a Idempotency-Key com o escopo (cliente e rota) em que foi usada
+type GetTransactionInput struct {
+	ID               string
+	IdempotencyKey   string
+	IdempotencyScope string // Ver gateway.IdempotencyKey.Scope
+}
+
+type TransactionEntryOutput struct {
+	WalletID int64  `json:"wallet_id"`
+	Amount   int64  `json:"amount"` // Negativo = débito, Positivo = crédito
+	Currency string `json:"currency"`
+}
+
+// TransactionFXOutput só aparece em transferências entre moedas diferentes
+type TransactionFXOutput struct {
+	DestinationAmount   int64  `json:"destination_amount"`
+	DestinationCurrency string `json:"destination_currency"`
+	Rate                string `json:"rate"`
+	QuoteID             string `json:"quote_id"`
+}
+
+type GetTransactionOutput struct {
+	TransactionID  string               `json:"transaction_id"`
+	Kind           string               `json:"kind"`                     // transfer, journal, deposit ou withdrawal
+	FromWalletID   int64                `json:"from_wallet_id,omitempty"` // Ausente em lançamentos journal (veja entries)
+	ToWalletID     int64                `json:"to_wallet_id,omitempty"`
+	Amount         int64                `json:"amount"`
+	FeeAmount      int64                `json:"fee_amount"` // Cobrada do remetente além de amount
+	Currency       string               `json:"currency"`
+	Status         string               `json:"status"`
+	Description    string               `json:"description,omitempty"`
+	IdempotencyKey *string              `json:"idempotency_key,omitempty"`
+	FX             *TransactionFXOutput `json:"fx,omitempty"`
+	RefundedAmount int64                `json:"refunded_amount"`
+	// Preenchido quando a transação é o estorno de outra
+	OriginalTransactionID string                   `json:"original_transaction_id,omitempty"`
+	Entries               []TransactionEntryOutput `json:"entries"`
+	CreatedAt             string                   `json:"created_at"`
+}
+
+// GetTransactionUseCase permite ler de volta uma transferência.
+// Essencial para clientes que perderam a resposta HTTP e precisam saber se o dinheiro foi movido.
+type GetTransactionUseCase struct {
+	transactionRepository gateway.TransactionRepository
+	entryRepository       gateway.EntryRepository
+}
+
+func NewGetTransaction(transactionRepo gateway.TransactionRepository, entryRepo gateway.EntryRepository) *GetTransactionUseCase {
+	return &GetTransactionUseCase{
+		transactionRepository: transactionRepo,
+		entryRepository:       entryRepo,
+	}
+}
+
+func (u *GetTransactionUseCase) Execute(ctx context.Context, input GetTransactionInput) (*GetTransactionOutput, error) {
+	var (
+		transaction *domain.Transaction
+		err         error
+	)
+
+	if input.ID != "" {
+		transaction, err = u.transactionRepository.GetByID(ctx, input.ID)
+	} else {
+		transaction, err = u.transactionRepository.GetByIdempotencyKey(ctx, input.IdempotencyScope, input.IdempotencyKey)
+	}
+	if err != nil {
+		if err == domain.ErrTransactionNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar transação: %w", err)
+	}
+
+	entries, err := u.entryRepository.ListByTransaction(ctx, transaction.ID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar partidas da transação: %w", err)
+	}
+
+	output := &GetTransactionOutput{
+		TransactionID:  transaction.ID,
+		Kind:           transaction.Kind,
+		FromWalletID:   transaction.FromWalletID,
+		ToWalletID:     transaction.ToWalletID,
+		Amount:         transaction.Amount,
+		FeeAmount:      transaction.FeeAmount,
+		Currency:       string(transaction.Currency),
+		Status:         transaction.Status,
+		Description:    transaction.Description,
+		IdempotencyKey: transaction.IdempotencyKey,
+		RefundedAmount: transaction.RefundedAmount,
+
+		OriginalTransactionID: transaction.OriginalTransactionID,
+		Entries:               make([]TransactionEntryOutput, 0, len(entries)),
+		CreatedAt:             transaction.CreatedAt.Format(time.RFC3339),
+	}
+	for _, e := range entries {
+		output.Entries = append(output.Entries, TransactionEntryOutput{
+			WalletID: e.WalletID,
+			Amount:   e.Amount,
+			Currency: string(e.Currency),
+		})
+	}
+	if transaction.IsCrossCurrency() {
+		output.FX = &TransactionFXOutput{
+			DestinationAmount:   transaction.DestinationAmount,
+			DestinationCurrency: string(transaction.DestinationCurrency),
+			Rate:                transaction.FXRate.FloatString(domain.RatePrecision),
+			QuoteID:             transaction.FXQuoteID,
+		}
+	}
+
+	return output, nil
+}
@@ -9,9 +9,16 @@ import (
 )
 
 type GetWalletOutput struct {
-	ID        int64  `json:"id"`
-	Balance   int64  `json:"balance"`
-	UpdatedAt string `json:"updated_at"`
+	ID         int64  `json:"id"`
+	CustomerID *int64 `json:"customer_id,omitempty"` // Ausente em carteiras sem titular (sistema)
+	Balance    int64  `json:"balance"`               // Na menor unidade da moeda
+	// Reservado por autorizações ativas; o disponível é balance - held_amount + overdraft_limit
+	HeldAmount       int64  `json:"held_amount"`
+	OverdraftLimit   int64  `json:"overdraft_limit"`
+	AvailableBalance int64  `json:"available_balance"`
+	Currency         string `json:"currency"`
+	Status           string `json:"status"`
+	UpdatedAt        string `json:"updated_at"`
 }
 
 type GetWalletUseCase struct {
@@ -35,9 +42,19 @@ func (u *GetWalletUseCase) Execute(ctx context.Context, walletID int64) (*GetWal
 		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
 	}
 
+	return toWalletOutput(wallet), nil
+}
+
+func toWalletOutput(wallet *domain.Wallet) *GetWalletOutput {
 	return &GetWalletOutput{
-		ID:        wallet.ID,
-		Balance:   wallet.Balance,
-		UpdatedAt: wallet.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}, nil
+		ID:               wallet.ID,
+		CustomerID:       wallet.CustomerID,
+		Balance:          wallet.Balance,
+		HeldAmount:       wallet.HeldAmount,
+		OverdraftLimit:   wallet.OverdraftLimit,
+		AvailableBalance: wallet.AvailableBalance(),
+		Currency:         string(wallet.Currency),
+		Status:           wallet.Status,
+		UpdatedAt:        wallet.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
 }
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type GetWalletBalanceInput struct {
+	WalletID int64
+	AsOf     *time.Time // Opcional: padrão agora
+}
+
+type WalletBalanceOutput struct {
+	WalletID int64  `json:"wallet_id"`
+	AsOf     string `json:"as_of"`
+	Balance  int64  `json:"balance"` // Na menor unidade da moeda, contando as partidas até as_of (inclusive)
+	Currency string `json:"currency"`
+	// Fotografia de fim de dia usada como ponto de partida (ausente se a soma partiu do início do ledger)
+	SnapshotDate string `json:"snapshot_date,omitempty"`
+}
+
+// GetWalletBalanceUseCase responde "qual era o saldo da carteira em tal instante", a partir do ledger
+type GetWalletBalanceUseCase struct {
+	walletRepository          gateway.WalletRepository
+	entryRepository           gateway.EntryRepository
+	balanceSnapshotRepository gateway.BalanceSnapshotRepository
+}
+
+func NewGetWalletBalance(
+	walletRepo gateway.WalletRepository,
+	entryRepo gateway.EntryRepository,
+	snapshotRepo gateway.BalanceSnapshotRepository,
+) *GetWalletBalanceUseCase {
+	return &GetWalletBalanceUseCase{
+		walletRepository:          walletRepo,
+		entryRepository:           entryRepo,
+		balanceSnapshotRepository: snapshotRepo,
+	}
+}
+
+func (u *GetWalletBalanceUseCase) Execute(ctx context.Context, input GetWalletBalanceInput) (*WalletBalanceOutput, error) {
+	now := time.Now()
+	asOf := now
+	if input.AsOf != nil {
+		if input.AsOf.After(now) {
+			return nil, domain.ErrInvalidBalanceDate
+		}
+		asOf = *input.AsOf
+	}
+
+	wallet, err := u.walletRepository.GetByID(ctx, input.WalletID)
+	if err != nil {
+		if err == domain.ErrWalletNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
+	}
+
+	// O Postgres guarda microssegundos: "até as_of, inclusive" é "antes de as_of + 1µs"
+	balance, snapshot, err := balanceBefore(ctx, u.entryRepository, u.balanceSnapshotRepository, wallet.ID, asOf.Add(time.Microsecond))
+	if err != nil {
+		return nil, err
+	}
+
+	output := &WalletBalanceOutput{
+		WalletID: wallet.ID,
+		AsOf:     asOf.Format(time.RFC3339Nano),
+		Balance:  balance,
+		Currency: string(wallet.Currency),
+	}
+	if snapshot != nil {
+		output.SnapshotDate = snapshot.Date.Format("2006-01-02")
+	}
+	return output, nil
+}
+
+// balanceBefore reconstrói o saldo das partidas anteriores a 'at': parte da última fotografia de
+// fim de dia e soma só as partidas depois dela. Sem fotografia, soma o histórico inteiro.
+func balanceBefore(
+	ctx context.Context,
+	entryRepo gateway.EntryRepository,
+	snapshotRepo gateway.BalanceSnapshotRepository,
+	walletID int64,
+	at time.Time,
+) (int64, *domain.BalanceSnapshot, error) {
+	snapshot, err := snapshotRepo.GetLatest(ctx, walletID, at)
+	if err != nil {
+		return 0, nil, fmt.Errorf("erro ao buscar fotografia de saldo: %w", err)
+	}
+	if snapshot == nil {
+		balance, err := entryRepo.BalanceAt(ctx, walletID, at)
+		if err != nil {
+			return 0, nil, fmt.Errorf("erro ao reconstruir saldo pelo ledger: %w", err)
+		}
+		return balance, nil, nil
+	}
+
+	moved, err := entryRepo.SumBetween(ctx, walletID, snapshot.ClosesAt, at)
+	if err != nil {
+		return 0, nil, fmt.Errorf("erro ao somar partidas depois da fotografia: %w", err)
+	}
+	return snapshot.Balance + moved, snapshot, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// walletInterestPayoutsShown limita o histórico de pagamentos devolvido junto com a configuração
+const walletInterestPayoutsShown = 12
+
+type InterestPayoutOutput struct {
+	Period        string `json:"period"` // Mês pago (AAAA-MM)
+	Amount        int64  `json:"amount"`
+	TransactionID string `json:"transaction_id,omitempty"` // Ausente quando o mês rendeu menos de um centavo
+	PaidAt        string `json:"paid_at"`
+}
+
+type WalletInterestOutput struct {
+	WalletID   int64  `json:"wallet_id"`
+	AnnualRate string `json:"annual_rate"` // 0.10750000 = 10,75% a.a.
+	DayCount   string `json:"day_count"`
+	StartDate  string `json:"start_date"`
+	// Juros acumulados e ainda não pagos, na menor unidade da moeda, com a fração de centavo
+	// (ex: "153.482917" = R$ 1,53 e uma fração que fica para o próximo pagamento)
+	PendingAmount string                 `json:"pending_amount"`
+	Payouts       []InterestPayoutOutput `json:"payouts"`
+}
+
+type GetWalletInterestUseCase struct {
+	interestRepository gateway.InterestRepository
+}
+
+func NewGetWalletInterest(interestRepo gateway.InterestRepository) *GetWalletInterestUseCase {
+	return &GetWalletInterestUseCase{
+		interestRepository: interestRepo,
+	}
+}
+
+func (u *GetWalletInterestUseCase) Execute(ctx context.Context, walletID int64) (*WalletInterestOutput, error) {
+	account, err := u.interestRepository.GetAccount(ctx, walletID)
+	if err != nil {
+		if err == domain.ErrInterestNotEnabled {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar rendimento da carteira: %w", err)
+	}
+
+	units, err := u.interestRepository.SumUnpaidAccruals(ctx, walletID, domain.BusinessDate(time.Now()))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao somar juros acumulados: %w", err)
+	}
+	payouts, err := u.interestRepository.ListPayouts(ctx, walletID, walletInterestPayoutsShown)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar pagamentos de juros: %w", err)
+	}
+
+	pending := new(big.Rat).SetFrac(units.Add(units, account.CarryUnits), big.NewInt(domain.InterestUnitsPerMinor))
+	output := &WalletInterestOutput{
+		WalletID:      account.WalletID,
+		AnnualRate:    account.AnnualRate.FloatString(domain.InterestRatePrecision),
+		DayCount:      account.DayCount,
+		StartDate:     account.StartDate.Format("2006-01-02"),
+		PendingAmount: pending.FloatString(6),
+		Payouts:       make([]InterestPayoutOutput, 0, len(payouts)),
+	}
+	for _, payout := range payouts {
+		output.Payouts = append(output.Payouts, InterestPayoutOutput{
+			Period:        payout.PeriodStart.Format("2006-01"),
+			Amount:        payout.Amount,
+			TransactionID: payout.TransactionID,
+			PaidAt:        payout.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return output, nil
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// VelocityLimitsOutput representa um conjunto de limites. null = sem limite (ou, nas sobrescritas, herda da faixa).
+type VelocityLimitsOutput struct {
+	MaxSingleAmount   *int64 `json:"max_single_amount"`
+	DailyAmount       *int64 `json:"daily_amount"`
+	DailyCount        *int64 `json:"daily_count"`
+	MonthlyAmount     *int64 `json:"monthly_amount"`
+	MonthlyCount      *int64 `json:"monthly_count"`
+	NightSingleAmount *int64 `json:"night_single_amount"`
+	NightAmount       *int64 `json:"night_amount"`
+}
+
+type OutgoingUsageOutput struct {
+	DailyAmount   int64 `json:"daily_amount"`
+	DailyCount    int64 `json:"daily_count"`
+	MonthlyAmount int64 `json:"monthly_amount"`
+	MonthlyCount  int64 `json:"monthly_count"`
+	NightAmount   int64 `json:"night_amount"`
+	NightCount    int64 `json:"night_count"`
+}
+
+type GetWalletLimitsOutput struct {
+	WalletID  int64                `json:"wallet_id"`
+	Currency  string               `json:"currency"`
+	Tier      string               `json:"tier"`
+	Overrides VelocityLimitsOutput `json:"overrides"`
+	Effective VelocityLimitsOutput `json:"effective"`
+	Usage     OutgoingUsageOutput  `json:"usage"`
+	// Remaining é quanto ainda pode sair agora em cada limite
+	Remaining VelocityLimitsOutput `json:"remaining"`
+	// Night indica se a janela noturna (20:00-06:00) está em vigor
+	Night bool `json:"night"`
+}
+
+// GetWalletLimitsUseCase mostra os limites efetivos da carteira e quanto ainda resta de cada um
+type GetWalletLimitsUseCase struct {
+	walletRepository gateway.WalletRepository
+	limitRepository  gateway.LimitRepository
+}
+
+func NewGetWalletLimits(walletRepo gateway.WalletRepository, limitRepo gateway.LimitRepository) *GetWalletLimitsUseCase {
+	return &GetWalletLimitsUseCase{
+		walletRepository: walletRepo,
+		limitRepository:  limitRepo,
+	}
+}
+
+func (u *GetWalletLimitsUseCase) Execute(ctx context.Context, walletID int64) (*GetWalletLimitsOutput, error) {
+	wallet, err := u.walletRepository.GetByID(ctx, walletID)
+	if err != nil {
+		if err == domain.ErrWalletNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
+	}
+
+	walletLimits, limits, err := effectiveLimits(ctx, u.limitRepository, walletID)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := domain.LimitWindowsAt(time.Now())
+	usage, err := u.limitRepository.OutgoingUsage(ctx, walletID, windows)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao somar saídas da carteira: %w", err)
+	}
+
+	return &GetWalletLimitsOutput{
+		WalletID:  wallet.ID,
+		Currency:  string(wallet.Currency),
+		Tier:      walletLimits.Tier,
+		Overrides: toVelocityLimitsOutput(walletLimits.Overrides),
+		Effective: toVelocityLimitsOutput(limits),
+		Usage: OutgoingUsageOutput{
+			DailyAmount:   usage.DailyAmount,
+			DailyCount:    usage.DailyCount,
+			MonthlyAmount: usage.MonthlyAmount,
+			MonthlyCount:  usage.MonthlyCount,
+			NightAmount:   usage.NightAmount,
+			NightCount:    usage.NightCount,
+		},
+		Remaining: VelocityLimitsOutput{
+			MaxSingleAmount:   limits.MaxSingleAmount,
+			DailyAmount:       remainingLimit(limits.DailyAmount, usage.DailyAmount),
+			DailyCount:        remainingLimit(limits.DailyCount, usage.DailyCount),
+			MonthlyAmount:     remainingLimit(limits.MonthlyAmount, usage.MonthlyAmount),
+			MonthlyCount:      remainingLimit(limits.MonthlyCount, usage.MonthlyCount),
+			NightSingleAmount: limits.NightSingleAmount,
+			NightAmount:       remainingLimit(limits.NightAmount, usage.NightAmount),
+		},
+		Night: windows.Night,
+	}, nil
+}
+
+// remainingLimit devolve nil (sem limite) ou o que sobra do limite, nunca negativo
+func remainingLimit(limit *int64, used int64) *int64 {
+	if limit == nil {
+		return nil
+	}
+	remaining := max(*limit-used, 0)
+	return &remaining
+}
+
+func toVelocityLimitsOutput(l domain.VelocityLimits) VelocityLimitsOutput {
+	return VelocityLimitsOutput{
+		MaxSingleAmount:   l.MaxSingleAmount,
+		DailyAmount:       l.DailyAmount,
+		DailyCount:        l.DailyCount,
+		MonthlyAmount:     l.MonthlyAmount,
+		MonthlyCount:      l.MonthlyCount,
+		NightSingleAmount: l.NightSingleAmount,
+		NightAmount:       l.NightAmount,
+	}
+}
+
+// ToDomain converte a entrada da API (mesmo formato da saída) para o domínio
+func (o VelocityLimitsOutput) ToDomain() domain.VelocityLimits {
+	return domain.VelocityLimits{
+		MaxSingleAmount:   o.MaxSingleAmount,
+		DailyAmount:       o.DailyAmount,
+		DailyCount:        o.DailyCount,
+		MonthlyAmount:     o.MonthlyAmount,
+		MonthlyCount:      o.MonthlyCount,
+		NightSingleAmount: o.NightSingleAmount,
+		NightAmount:       o.NightAmount,
+	}
+}
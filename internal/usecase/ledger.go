@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// postEntries grava as partidas dobradas de uma transação.
+// Para cada lançamento, o saldo materializado (wallets.balance) é atualizado
+// e a partida correspondente é registrada em entries, tudo na mesma transação.
+// Deve ser chamado dentro de um Uow, com as carteiras envolvidas já travadas.
+func postEntries(
+	ctx context.Context,
+	walletRepo gateway.WalletRepository,
+	entryRepo gateway.EntryRepository,
+	entries []domain.Entry,
+) error {
+	if err := domain.ValidateBalanced(entries); err != nil {
+		return err
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+
+		if entry.IsDebit() {
+			// O método Debit do repositório já verifica se tem saldo (balance >= amount).
+			if err := walletRepo.Debit(ctx, entry.WalletID, -entry.Amount); err != nil {
+				return fmt.Errorf("falha no débito (carteira %d): %w", entry.WalletID, err)
+			}
+		} else {
+			if err := walletRepo.Credit(ctx, entry.WalletID, entry.Amount); err != nil {
+				return fmt.Errorf("falha no crédito (carteira %d): %w", entry.WalletID, err)
+			}
+		}
+
+		if err := entryRepo.Create(ctx, entry); err != nil {
+			return fmt.Errorf("falha ao registrar partida (carteira %d): %w", entry.WalletID, err)
+		}
+	}
+
+	return nil
+}
+
+// lockWallets trava (SELECT ... FOR UPDATE) todas as carteiras informadas, sempre em ordem
+// crescente de ID. Se duas operações concorrentes travarem carteiras em comum,
+// ambas tentam o ID menor primeiro, então uma espera a outra em vez de gerar Deadlock.
+func lockWallets(ctx context.Context, walletRepo gateway.WalletRepository, ids ...int64) (map[int64]*domain.Wallet, error) {
+	sorted := make([]int64, 0, len(ids))
+	seen := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			sorted = append(sorted, id)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	wallets := make(map[int64]*domain.Wallet, len(sorted))
+	for _, id := range sorted {
+		wallet, err := walletRepo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("falha ao travar carteira %d: %w", id, err)
+		}
+		wallets[id] = wallet
+	}
+	return wallets, nil
+}
@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type ListCustomerWalletsOutput struct {
+	Items []GetWalletOutput `json:"items"`
+}
+
+type ListCustomerWalletsUseCase struct {
+	customerRepository gateway.CustomerRepository
+	walletRepository   gateway.WalletRepository
+}
+
+func NewListCustomerWallets(customerRepo gateway.CustomerRepository, walletRepo gateway.WalletRepository) *ListCustomerWalletsUseCase {
+	return &ListCustomerWalletsUseCase{
+		customerRepository: customerRepo,
+		walletRepository:   walletRepo,
+	}
+}
+
+func (u *ListCustomerWalletsUseCase) Execute(ctx context.Context, customerID int64) (*ListCustomerWalletsOutput, error) {
+	// Garante 404 para cliente inexistente em vez de uma lista vazia
+	if _, err := u.customerRepository.GetByID(ctx, customerID); err != nil {
+		if err == domain.ErrCustomerNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar cliente: %w", err)
+	}
+
+	wallets, err := u.walletRepository.ListByCustomer(ctx, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar carteiras do cliente: %w", err)
+	}
+
+	output := &ListCustomerWalletsOutput{Items: make([]GetWalletOutput, 0, len(wallets))}
+	for i := range wallets {
+		output.Items = append(output.Items, *toWalletOutput(&wallets[i]))
+	}
+	return output, nil
+}
@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type ListCustomersInput struct {
+	Cursor string // Opaco: devolvido em NextCursor na página anterior
+	Limit  int
+}
+
+type ListCustomersOutput struct {
+	Items      []CustomerOutput `json:"items"`
+	NextCursor string           `json:"next_cursor,omitempty"`
+}
+
+// ListCustomersUseCase lista os clientes em ordem de cadastro, com paginação por cursor (keyset)
+type ListCustomersUseCase struct {
+	customerRepository gateway.CustomerRepository
+}
+
+func NewListCustomers(customerRepo gateway.CustomerRepository) *ListCustomersUseCase {
+	return &ListCustomersUseCase{
+		customerRepository: customerRepo,
+	}
+}
+
+func (u *ListCustomersUseCase) Execute(ctx context.Context, input ListCustomersInput) (*ListCustomersOutput, error) {
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	var afterID int64
+	if input.Cursor != "" {
+		var err error
+		if afterID, err = decodeCursor(input.Cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// Buscamos um item a mais para saber se existe próxima página
+	customers, err := u.customerRepository.List(ctx, afterID, int32(limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar clientes: %w", err)
+	}
+
+	output := &ListCustomersOutput{Items: make([]CustomerOutput, 0, limit)}
+	if len(customers) > limit {
+		customers = customers[:limit]
+		output.NextCursor = encodeCursor(customers[limit-1].ID)
+	}
+	for i := range customers {
+		output.Items = append(output.Items, *toCustomerOutput(&customers[i]))
+	}
+	return output, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type FeePolicyOutput struct {
+	Tier            string              `json:"tier"`
+	Currency        string              `json:"currency"`
+	Kind            string              `json:"kind"`
+	FlatAmount      int64               `json:"flat_amount,omitempty"`
+	PercentageBps   int64               `json:"percentage_bps,omitempty"`
+	MinAmount       *int64              `json:"min_amount,omitempty"`
+	MaxAmount       *int64              `json:"max_amount,omitempty"`
+	Brackets        []domain.FeeBracket `json:"brackets,omitempty"`
+	RevenueWalletID *int64              `json:"revenue_wallet_id,omitempty"` // Ausente = carteira fee_revenue da moeda
+	UpdatedAt       string              `json:"updated_at"`
+}
+
+type ListFeePoliciesOutput struct {
+	Items []FeePolicyOutput `json:"items"`
+}
+
+type ListFeePoliciesUseCase struct {
+	feePolicyRepository gateway.FeePolicyRepository
+}
+
+func NewListFeePolicies(feePolicyRepo gateway.FeePolicyRepository) *ListFeePoliciesUseCase {
+	return &ListFeePoliciesUseCase{
+		feePolicyRepository: feePolicyRepo,
+	}
+}
+
+func (u *ListFeePoliciesUseCase) Execute(ctx context.Context) (*ListFeePoliciesOutput, error) {
+	policies, err := u.feePolicyRepository.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar políticas de tarifa: %w", err)
+	}
+
+	output := &ListFeePoliciesOutput{Items: make([]FeePolicyOutput, 0, len(policies))}
+	for i := range policies {
+		output.Items = append(output.Items, *toFeePolicyOutput(&policies[i]))
+	}
+	return output, nil
+}
+
+func toFeePolicyOutput(policy *domain.FeePolicy) *FeePolicyOutput {
+	return &FeePolicyOutput{
+		Tier:            policy.Tier,
+		Currency:        string(policy.Currency),
+		Kind:            policy.Kind,
+		FlatAmount:      policy.FlatAmount,
+		PercentageBps:   policy.PercentageBps,
+		MinAmount:       policy.MinAmount,
+		MaxAmount:       policy.MaxAmount,
+		Brackets:        policy.Brackets,
+		RevenueWalletID: policy.RevenueWalletID,
+		UpdatedAt:       policy.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// IdempotencyKeysInput seleciona as gravações de uma Idempotency-Key (ClientID e Route são opcionais)
+type IdempotencyKeysInput struct {
+	Key      string
+	ClientID string
+	Route    string // "MÉTODO padrão", ex: "POST /transfers"
+}
+
+type IdempotencyResponseOutput struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       json.RawMessage     `json:"body"` // Corpos que não são JSON vêm como string
+}
+
+type IdempotencyKeyOutput struct {
+	ClientID    string                     `json:"client_id"`
+	Route       string                     `json:"route"`
+	Key         string                     `json:"key"`
+	Status      string                     `json:"status"` // processing ou completed
+	Fingerprint string                     `json:"fingerprint"`
+	Response    *IdempotencyResponseOutput `json:"response,omitempty"`
+	LockedUntil string                     `json:"locked_until,omitempty"`
+	CreatedAt   string                     `json:"created_at"`
+	CompletedAt string                     `json:"completed_at,omitempty"`
+	ExpiresAt   string                     `json:"expires_at"`
+}
+
+type ListIdempotencyKeysOutput struct {
+	Items []IdempotencyKeyOutput `json:"items"`
+}
+
+// ListIdempotencyKeysUseCase mostra o que está gravado para uma chave, em todos os clientes e rotas
+type ListIdempotencyKeysUseCase struct {
+	idempotencyRepository gateway.IdempotencyAdminRepository
+}
+
+func NewListIdempotencyKeys(idempotencyRepo gateway.IdempotencyAdminRepository) *ListIdempotencyKeysUseCase {
+	return &ListIdempotencyKeysUseCase{
+		idempotencyRepository: idempotencyRepo,
+	}
+}
+
+func (u *ListIdempotencyKeysUseCase) Execute(ctx context.Context, input IdempotencyKeysInput) (*ListIdempotencyKeysOutput, error) {
+	filter, err := toIdempotencyFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := u.idempotencyRepository.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar chaves de idempotência: %w", err)
+	}
+
+	output := &ListIdempotencyKeysOutput{Items: make([]IdempotencyKeyOutput, 0, len(records))}
+	for i := range records {
+		output.Items = append(output.Items, toIdempotencyKeyOutput(&records[i]))
+	}
+	return output, nil
+}
+
+func toIdempotencyFilter(input IdempotencyKeysInput) (gateway.IdempotencyFilter, error) {
+	filter := gateway.IdempotencyFilter{
+		Key:      strings.TrimSpace(input.Key),
+		ClientID: strings.TrimSpace(input.ClientID),
+		Route:    strings.TrimSpace(input.Route),
+	}
+	if filter.Key == "" {
+		return filter, domain.ErrIdempotencyKeyRequired
+	}
+	return filter, nil
+}
+
+func toIdempotencyKeyOutput(record *gateway.IdempotencyRecord) IdempotencyKeyOutput {
+	output := IdempotencyKeyOutput{
+		ClientID:    record.ClientID,
+		Route:       record.Route,
+		Key:         record.Key,
+		Status:      record.Status,
+		Fingerprint: record.Fingerprint,
+		CreatedAt:   record.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:   record.ExpiresAt.Format(time.RFC3339),
+	}
+	if record.Response != nil {
+		body := json.RawMessage(record.Response.Body)
+		if !json.Valid(body) {
+			body, _ = json.Marshal(string(record.Response.Body))
+		}
+		output.Response = &IdempotencyResponseOutput{
+			StatusCode: record.Response.StatusCode,
+			Headers:    record.Response.Headers,
+			Body:       body,
+		}
+	}
+	if record.LockedUntil != nil {
+		output.LockedUntil = record.LockedUntil.Format(time.RFC3339)
+	}
+	if record.CompletedAt != nil {
+		output.CompletedAt = record.CompletedAt.Format(time.RFC3339)
+	}
+	return output
+}
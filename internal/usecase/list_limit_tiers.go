@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type LimitTierOutput struct {
+	Code      string               `json:"code"`
+	Limits    VelocityLimitsOutput `json:"limits"`
+	UpdatedAt string               `json:"updated_at"`
+}
+
+type ListLimitTiersOutput struct {
+	Items []LimitTierOutput `json:"items"`
+}
+
+type ListLimitTiersUseCase struct {
+	limitRepository gateway.LimitRepository
+}
+
+func NewListLimitTiers(limitRepo gateway.LimitRepository) *ListLimitTiersUseCase {
+	return &ListLimitTiersUseCase{
+		limitRepository: limitRepo,
+	}
+}
+
+func (u *ListLimitTiersUseCase) Execute(ctx context.Context) (*ListLimitTiersOutput, error) {
+	tiers, err := u.limitRepository.ListTiers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar faixas de limites: %w", err)
+	}
+
+	output := &ListLimitTiersOutput{Items: make([]LimitTierOutput, 0, len(tiers))}
+	for i := range tiers {
+		output.Items = append(output.Items, *toLimitTierOutput(&tiers[i]))
+	}
+	return output, nil
+}
+
+func toLimitTierOutput(tier *domain.LimitTier) *LimitTierOutput {
+	return &LimitTierOutput{
+		Code:      tier.Code,
+		Limits:    toVelocityLimitsOutput(tier.Limits),
+		UpdatedAt: tier.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Limite de registros de auditoria devolvidos por consulta
+const maxLimitChangesPerPage = 100
+
+type ListOverdraftLimitChangesOutput struct {
+	WalletID       int64                     `json:"wallet_id"`
+	OverdraftLimit int64                     `json:"overdraft_limit"`
+	Items          []WalletLimitChangeOutput `json:"items"`
+}
+
+// ListOverdraftLimitChangesUseCase devolve o limite atual e o histórico de alterações da carteira
+type ListOverdraftLimitChangesUseCase struct {
+	walletRepository      gateway.WalletRepository
+	limitChangeRepository gateway.WalletLimitChangeRepository
+}
+
+func NewListOverdraftLimitChanges(walletRepo gateway.WalletRepository, limitChangeRepo gateway.WalletLimitChangeRepository) *ListOverdraftLimitChangesUseCase {
+	return &ListOverdraftLimitChangesUseCase{
+		walletRepository:      walletRepo,
+		limitChangeRepository: limitChangeRepo,
+	}
+}
+
+func (u *ListOverdraftLimitChangesUseCase) Execute(ctx context.Context, walletID int64) (*ListOverdraftLimitChangesOutput, error) {
+	wallet, err := u.walletRepository.GetByID(ctx, walletID)
+	if err != nil {
+		if err == domain.ErrWalletNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
+	}
+
+	changes, err := u.limitChangeRepository.ListByWallet(ctx, walletID, maxLimitChangesPerPage)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar alterações de limite: %w", err)
+	}
+
+	output := &ListOverdraftLimitChangesOutput{
+		WalletID:       wallet.ID,
+		OverdraftLimit: wallet.OverdraftLimit,
+		Items:          make([]WalletLimitChangeOutput, 0, len(changes)),
+	}
+	for _, change := range changes {
+		output.Items = append(output.Items, *toWalletLimitChangeOutput(change))
+	}
+	return output, nil
+}
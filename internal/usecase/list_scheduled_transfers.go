@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Limite de agendamentos devolvidos por consulta
+const maxScheduledTransfersPerPage = 100
+
+type ListScheduledTransfersInput struct {
+	WalletID int64
+	Status   string // Vazio = pending. "all" lista todos os status
+}
+
+type ListScheduledTransfersOutput struct {
+	Items []ScheduledTransferOutput `json:"items"`
+}
+
+type ListScheduledTransfersUseCase struct {
+	walletRepository   gateway.WalletRepository
+	scheduleRepository gateway.ScheduledTransferRepository
+}
+
+func NewListScheduledTransfers(walletRepo gateway.WalletRepository, scheduleRepo gateway.ScheduledTransferRepository) *ListScheduledTransfersUseCase {
+	return &ListScheduledTransfersUseCase{
+		walletRepository:   walletRepo,
+		scheduleRepository: scheduleRepo,
+	}
+}
+
+func (u *ListScheduledTransfersUseCase) Execute(ctx context.Context, input ListScheduledTransfersInput) (*ListScheduledTransfersOutput, error) {
+	// Garante 404 para carteira inexistente em vez de uma lista vazia
+	if _, err := u.walletRepository.GetByID(ctx, input.WalletID); err != nil {
+		if err == domain.ErrWalletNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
+	}
+
+	status := input.Status
+	switch status {
+	case "":
+		status = domain.ScheduleStatusPending
+	case "all":
+		status = ""
+	}
+
+	schedules, err := u.scheduleRepository.ListByWallet(ctx, input.WalletID, status, maxScheduledTransfersPerPage)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar agendamentos: %w", err)
+	}
+
+	output := &ListScheduledTransfersOutput{Items: make([]ScheduledTransferOutput, 0, len(schedules))}
+	for i := range schedules {
+		output.Items = append(output.Items, *toScheduledTransferOutput(&schedules[i]))
+	}
+	return output, nil
+}
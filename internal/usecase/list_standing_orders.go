@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Limite de ordens devolvidas por consulta
+const maxStandingOrdersPerPage = 100
+
+type ListStandingOrdersOutput struct {
+	Items []StandingOrderOutput `json:"items"`
+}
+
+type ListStandingOrdersUseCase struct {
+	walletRepository gateway.WalletRepository
+	orderRepository  gateway.StandingOrderRepository
+}
+
+func NewListStandingOrders(walletRepo gateway.WalletRepository, orderRepo gateway.StandingOrderRepository) *ListStandingOrdersUseCase {
+	return &ListStandingOrdersUseCase{
+		walletRepository: walletRepo,
+		orderRepository:  orderRepo,
+	}
+}
+
+func (u *ListStandingOrdersUseCase) Execute(ctx context.Context, walletID int64) (*ListStandingOrdersOutput, error) {
+	// Garante 404 para carteira inexistente em vez de uma lista vazia
+	if _, err := u.walletRepository.GetByID(ctx, walletID); err != nil {
+		if err == domain.ErrWalletNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar carteira: %w", err)
+	}
+
+	orders, err := u.orderRepository.ListByWallet(ctx, walletID, maxStandingOrdersPerPage)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar ordens permanentes: %w", err)
+	}
+
+	output := &ListStandingOrdersOutput{Items: make([]StandingOrderOutput, 0, len(orders))}
+	for i := range orders {
+		output.Items = append(output.Items, *toStandingOrderOutput(&orders[i]))
+	}
+	return output, nil
+}
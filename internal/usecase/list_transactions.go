@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// ListTransactionsInput define os filtros do extrato. Campos nil ou vazios são ignorados.
+type ListTransactionsInput struct {
+	WalletID  int64
+	Cursor    string // Opaco: devolvido em NextCursor na página anterior
+	Limit     int
+	Direction string // "in" ou "out"
+	From      *time.Time
+	To        *time.Time
+	MinAmount *int64
+	MaxAmount *int64
+	Status    string
+}
+
+type TransactionHistoryItem struct {
+	TransactionID string `json:"transaction_id"`
+	Kind          string `json:"kind"`                     // transfer, journal, deposit ou withdrawal
+	FromWalletID  int64  `json:"from_wallet_id,omitempty"` // Ausente em lançamentos journal
+	ToWalletID    int64  `json:"to_wallet_id,omitempty"`
+	Amount        int64  `json:"amount"`               // Assinado: negativo = saída, positivo = entrada
+	FeeAmount     int64  `json:"fee_amount,omitempty"` // Tarifa da transação (já incluída na saída do remetente)
+	Currency      string `json:"currency"`
+	Direction     string `json:"direction"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type ListTransactionsOutput struct {
+	Items      []TransactionHistoryItem `json:"items"`
+	NextCursor string                   `json:"next_cursor,omitempty"`
+}
+
+// ListTransactionsUseCase monta o extrato de uma carteira com paginação por cursor (keyset).
+// Diferente de LIMIT/OFFSET, o custo não cresce com a página e novas transações
+// não fazem itens "pularem" entre páginas.
+type ListTransactionsUseCase struct {
+	walletRepository      gateway.WalletRepository
+	transactionRepository gateway.TransactionRepository
+}
+
+func NewListTransactions(walletRepo gateway.WalletRepository, transactionRepo gateway.TransactionRepository) *ListTransactionsUseCase {
+	return &ListTransactionsUseCase{
+		walletRepository:      walletRepo,
+		transactionRepository: transactionRepo,
+	}
+}
+
+func (u *ListTransactionsUseCase) Execute(ctx context.Context, input ListTransactionsInput) (*ListTransactionsOutput, error) {
+	if _, err := u.walletRepository.GetByID(ctx, input.WalletID); err != nil {
+		return nil, err
+	}
+
+	limit := input.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	if limit > maxPageSize {
+		limit = maxPageSize
+	}
+
+	filter := gateway.TransactionFilter{
+		WalletID:  input.WalletID,
+		Direction: input.Direction,
+		From:      input.From,
+		To:        input.To,
+		MinAmount: input.MinAmount,
+		MaxAmount: input.MaxAmount,
+		Status:    input.Status,
+		// Buscamos um item a mais para saber se existe próxima página
+		Limit: int32(limit + 1),
+	}
+
+	if input.Cursor != "" {
+		afterEntryID, err := decodeCursor(input.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter.AfterEntryID = &afterEntryID
+	}
+
+	rows, err := u.transactionRepository.ListByWallet(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar transações: %w", err)
+	}
+
+	output := &ListTransactionsOutput{Items: make([]TransactionHistoryItem, 0, limit)}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		output.NextCursor = encodeCursor(rows[limit-1].EntryID)
+	}
+
+	for _, row := range rows {
+		output.Items = append(output.Items, TransactionHistoryItem{
+			TransactionID: row.ID,
+			Kind:          row.Kind,
+			FromWalletID:  row.FromWalletID,
+			ToWalletID:    row.ToWalletID,
+			Amount:        row.SignedAmount,
+			FeeAmount:     row.FeeAmount,
+			Currency:      string(row.Currency),
+			Direction:     row.Direction(),
+			Status:        row.Status,
+			CreatedAt:     row.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return output, nil
+}
+
+// O cursor é opaco para o cliente: só o ID da última partida em base64
+func encodeCursor(entryID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(entryID, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, domain.ErrInvalidCursor
+	}
+	entryID, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || entryID <= 0 {
+		return 0, domain.ErrInvalidCursor
+	}
+	return entryID, nil
+}
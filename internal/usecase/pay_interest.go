@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type PayInterestOutput struct {
+	Paid        int // Carteiras que receberam juros (ou acumularam menos de um centavo no mês)
+	AlreadyPaid int // Carteiras com o mês já pago (reexecução do job)
+	Failed      int
+}
+
+// PayInterestUseCase paga os juros acumulados de um mês: uma transferência da tesouraria
+// (interest_treasury da moeda) para cada carteira. Só a parte inteira em centavos é paga;
+// a fração restante fica em carry_units e entra no pagamento seguinte, sem perda por arredondamento.
+type PayInterestUseCase struct {
+	walletRepository      gateway.WalletRepository
+	transactionRepository gateway.TransactionRepository
+	entryRepository       gateway.EntryRepository
+	interestRepository    gateway.InterestRepository
+	transactionManager    gateway.TransactionManager
+	eventPublisher        gateway.EventPublisher
+}
+
+func NewPayInterest(
+	walletRepo gateway.WalletRepository,
+	transactionRepo gateway.TransactionRepository,
+	entryRepo gateway.EntryRepository,
+	interestRepo gateway.InterestRepository,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *PayInterestUseCase {
+	return &PayInterestUseCase{
+		walletRepository:      walletRepo,
+		transactionRepository: transactionRepo,
+		entryRepository:       entryRepo,
+		interestRepository:    interestRepo,
+		transactionManager:    txManager,
+		eventPublisher:        publisher,
+	}
+}
+
+// Execute paga o mês que contém 'month'. O mês precisa ter terminado.
+func (u *PayInterestUseCase) Execute(ctx context.Context, month time.Time, now time.Time) (*PayInterestOutput, error) {
+	month = domain.BusinessDate(month)
+	periodStart := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, domain.BusinessLocation)
+	periodEnd := periodStart.AddDate(0, 1, -1)
+	if periodStart.AddDate(0, 1, 0).After(now) {
+		return nil, domain.ErrInvalidInterestDate
+	}
+
+	// Rendimentos de meses anteriores que ficaram sem pagamento entram neste
+	walletIDs, err := u.interestRepository.ListWalletsWithUnpaidAccruals(ctx, periodEnd)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar carteiras com juros a pagar: %w", err)
+	}
+
+	output := &PayInterestOutput{}
+	var firstErr error
+	for _, walletID := range walletIDs {
+		err := u.pay(ctx, walletID, periodStart, periodEnd)
+		switch {
+		case err == nil:
+			output.Paid++
+		case errors.Is(err, domain.ErrInterestAlreadyPaid):
+			output.AlreadyPaid++
+		default:
+			output.Failed++
+			if firstErr == nil {
+				firstErr = fmt.Errorf("falha ao pagar juros da carteira %d: %w", walletID, err)
+			}
+		}
+	}
+
+	return output, firstErr
+}
+
+func (u *PayInterestUseCase) pay(ctx context.Context, walletID int64, periodStart, periodEnd time.Time) error {
+	var transaction *domain.Transaction
+	var treasuryID int64
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+		transactionRepoTx := u.transactionRepository.WithTx(transactionObject)
+		entryRepoTx := u.entryRepository.WithTx(transactionObject)
+		interestRepoTx := u.interestRepository.WithTx(transactionObject)
+
+		// A configuração travada serializa execuções concorrentes do job para a mesma carteira
+		account, err := interestRepoTx.GetAccountForUpdate(contextWithTx, walletID)
+		if err != nil {
+			return err
+		}
+		existing, err := interestRepoTx.GetPayout(contextWithTx, walletID, periodStart)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			return domain.ErrInterestAlreadyPaid
+		}
+
+		units, err := interestRepoTx.SumUnpaidAccruals(contextWithTx, walletID, periodEnd)
+		if err != nil {
+			return err
+		}
+		amount, carry := domain.SplitInterestUnits(units.Add(units, account.CarryUnits))
+
+		payout := &domain.InterestPayout{WalletID: walletID, PeriodStart: periodStart, Amount: amount}
+		if amount > 0 {
+			wallet, err := walletRepoTx.GetByID(contextWithTx, walletID)
+			if err != nil {
+				return err
+			}
+			treasury, err := walletRepoTx.GetSystemWallet(contextWithTx, domain.SystemWalletInterestTreasury, wallet.Currency)
+			if err != nil {
+				return fmt.Errorf("tesouraria de juros %s não configurada: %w", wallet.Currency, err)
+			}
+			treasuryID = treasury.ID
+
+			wallets, err := lockWallets(contextWithTx, walletRepoTx, treasury.ID, walletID)
+			if err != nil {
+				return err
+			}
+			if err := wallets[walletID].CanCredit(); err != nil {
+				return err
+			}
+
+			// A chave impede um segundo pagamento do mesmo mês mesmo fora deste fluxo.
+			// Fica no escopo interno: a Idempotency-Key de um cliente não bloqueia o pagamento.
+			key := fmt.Sprintf("interest:%d:%s", walletID, periodStart.Format("2006-01"))
+			transaction = &domain.Transaction{
+				FromWalletID:     treasury.ID,
+				ToWalletID:       walletID,
+				Amount:           amount,
+				Currency:         wallet.Currency,
+				Status:           domain.TransactionStatusCompleted,
+				IdempotencyKey:   &key,
+				IdempotencyScope: domain.IdempotencyScopeInternal,
+				Description:      "Juros " + periodStart.Format("01/2006"),
+			}
+			if err := transactionRepoTx.Create(contextWithTx, transaction); err != nil {
+				return fmt.Errorf("falha ao salvar histórico da transação: %w", err)
+			}
+
+			entries := []domain.Entry{
+				{TransactionID: transaction.ID, WalletID: treasury.ID, Amount: -amount, Currency: wallet.Currency},
+				{TransactionID: transaction.ID, WalletID: walletID, Amount: amount, Currency: wallet.Currency},
+			}
+			if err := postEntries(contextWithTx, walletRepoTx, entryRepoTx, entries); err != nil {
+				return err
+			}
+			payout.TransactionID = transaction.ID
+		}
+
+		if err := interestRepoTx.CreatePayout(contextWithTx, payout, periodEnd); err != nil {
+			return err
+		}
+		return interestRepoTx.UpdateCarry(contextWithTx, walletID, carry)
+	})
+	if err != nil {
+		return err
+	}
+
+	// O pagamento é uma transferência: segue para a auditoria como as demais
+	if u.eventPublisher != nil && transaction != nil {
+		event := transferEvent(TransferMoneyInput{FromWalletID: treasuryID, ToWalletID: walletID}, transaction, transaction.Status)
+		event["description"] = transaction.Description
+		_ = u.eventPublisher.Publish(ctx, "ledger_events", "transaction."+transaction.Status, event)
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// MaxJournalLegs limita as pernas de um lançamento (e quantas carteiras ficam travadas juntas)
+const MaxJournalLegs = 100
+
+// JournalLeg é uma perna do lançamento. Negativo = débito, Positivo = crédito.
+type JournalLeg struct {
+	WalletID int64
+	Amount   domain.Money // A moeda é opcional: vazia, vale a da carteira
+}
+
+type PostJournalEntryInput struct {
+	Legs           []JournalLeg
+	Description    string
+	IdempotencyKey *string
+	// Escopo (cliente e rota) da IdempotencyKey, ver gateway.IdempotencyKey.Scope
+	IdempotencyScope string
+}
+
+type PostJournalEntryOutput struct {
+	TransactionID string                   `json:"transaction_id"`
+	Status        string                   `json:"status"`
+	Legs          []TransactionEntryOutput `json:"legs"`
+	CreatedAt     string                   `json:"created_at"`
+}
+
+// PostJournalEntryUseCase grava um conjunto arbitrário de pernas balanceadas sob uma única transação.
+// Ex: comprador -> lojista, comprador -> taxa da plataforma e comprador -> imposto, de uma vez.
+type PostJournalEntryUseCase struct {
+	walletRepository      gateway.WalletRepository
+	transactionRepository gateway.TransactionRepository
+	entryRepository       gateway.EntryRepository
+	limitRepository       gateway.LimitRepository
+	transactionManager    gateway.TransactionManager
+	eventPublisher        gateway.EventPublisher
+}
+
+func NewPostJournalEntry(
+	walletRepo gateway.WalletRepository,
+	transactionRepo gateway.TransactionRepository,
+	entryRepo gateway.EntryRepository,
+	limitRepo gateway.LimitRepository,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *PostJournalEntryUseCase {
+	return &PostJournalEntryUseCase{
+		walletRepository:      walletRepo,
+		transactionRepository: transactionRepo,
+		entryRepository:       entryRepo,
+		limitRepository:       limitRepo,
+		transactionManager:    txManager,
+		eventPublisher:        publisher,
+	}
+}
+
+func (u *PostJournalEntryUseCase) Execute(ctx context.Context, input PostJournalEntryInput) (*PostJournalEntryOutput, error) {
+	var (
+		transaction *domain.Transaction
+		entries     []domain.Entry
+	)
+	transactionStatus := domain.TransactionStatusFailed
+
+	// Um único evento com todas as pernas, publicado no sucesso ou na falha
+	defer func() {
+		if u.eventPublisher != nil {
+			event := journalEvent(input, transaction, entries, transactionStatus)
+			_ = u.eventPublisher.Publish(ctx, "ledger_events", "transaction."+transactionStatus, event)
+		}
+	}()
+
+	if len(input.Legs) < 2 || len(input.Legs) > MaxJournalLegs {
+		return nil, domain.ErrInvalidJournal
+	}
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+		transactionRepoTx := u.transactionRepository.WithTx(transactionObject)
+		entryRepoTx := u.entryRepository.WithTx(transactionObject)
+
+		walletIDs := make([]int64, 0, len(input.Legs))
+		for _, leg := range input.Legs {
+			walletIDs = append(walletIDs, leg.WalletID)
+		}
+		// Todas as carteiras de uma vez, em ordem crescente de ID (mesma regra das transferências)
+		wallets, err := lockWallets(contextWithTx, walletRepoTx, walletIDs...)
+		if err != nil {
+			return err
+		}
+
+		legs := make([]domain.Entry, 0, len(input.Legs))
+		for _, leg := range input.Legs {
+			wallet := wallets[leg.WalletID]
+			if wallet.IsSystem() {
+				return domain.ErrSystemWallet
+			}
+			statusCheck := wallet.CanCredit
+			if leg.Amount.Amount < 0 {
+				statusCheck = wallet.CanDebit
+			}
+			if err := statusCheck(); err != nil {
+				return err
+			}
+			amount, err := leg.Amount.In(wallet.Currency)
+			if err != nil {
+				return err
+			}
+			legs = append(legs, domain.Entry{WalletID: wallet.ID, Amount: amount.Amount, Currency: amount.Currency})
+		}
+		// Cada moeda precisa fechar em zero
+		if err := domain.ValidateBalanced(legs); err != nil {
+			return err
+		}
+
+		// As pernas de débito consomem os limites de movimentação como uma transferência:
+		// várias pernas da mesma carteira contam como uma saída só, com o total delas
+		if err := u.checkDebitLimits(contextWithTx, u.limitRepository.WithTx(transactionObject), legs); err != nil {
+			return err
+		}
+
+		// O cabeçalho resume o lançamento na moeda da primeira perna: amount = total creditado nela
+		header := &domain.Transaction{
+			Kind:             domain.TransactionKindJournal,
+			Currency:         legs[0].Currency,
+			Status:           domain.TransactionStatusCompleted,
+			IdempotencyKey:   input.IdempotencyKey,
+			IdempotencyScope: input.IdempotencyScope,
+			Description:      input.Description,
+		}
+		for _, leg := range legs {
+			if leg.Currency == header.Currency && !leg.IsDebit() {
+				header.Amount += leg.Amount
+			}
+		}
+		if err := transactionRepoTx.Create(contextWithTx, header); err != nil {
+			return fmt.Errorf("falha ao salvar histórico da transação: %w", err)
+		}
+
+		for i := range legs {
+			legs[i].TransactionID = header.ID
+		}
+		// As pernas são aplicadas na ordem recebida: um débito precisa de saldo disponível naquele ponto
+		if err := postEntries(contextWithTx, walletRepoTx, entryRepoTx, legs); err != nil {
+			return err
+		}
+
+		transaction, entries = header, legs
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	transactionStatus = transaction.Status
+
+	output := &PostJournalEntryOutput{
+		TransactionID: transaction.ID,
+		Status:        transaction.Status,
+		Legs:          make([]TransactionEntryOutput, 0, len(entries)),
+		CreatedAt:     transaction.CreatedAt.Format(time.RFC3339),
+	}
+	for _, e := range entries {
+		output.Legs = append(output.Legs, TransactionEntryOutput{
+			WalletID: e.WalletID,
+			Amount:   e.Amount,
+			Currency: string(e.Currency),
+		})
+	}
+	return output, nil
+}
+
+// checkDebitLimits valida o total debitado de cada carteira contra os limites dela.
+// As carteiras já estão travadas (lockWallets): a soma das saídas não muda até o Commit.
+func (u *PostJournalEntryUseCase) checkDebitLimits(ctx context.Context, limitRepo gateway.LimitRepository, legs []domain.Entry) error {
+	debits := make(map[int64]int64)
+	var walletIDs []int64
+	for _, leg := range legs {
+		if !leg.IsDebit() {
+			continue
+		}
+		if _, seen := debits[leg.WalletID]; !seen {
+			walletIDs = append(walletIDs, leg.WalletID)
+		}
+		debits[leg.WalletID] -= leg.Amount
+	}
+
+	now := time.Now()
+	for _, walletID := range walletIDs {
+		if err := checkVelocityLimits(ctx, limitRepo, walletID, debits[walletID], now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// journalEvent monta o payload publicado em ledger_events.
+// Sem origem/destino únicos: as pernas vão em "legs" (as recebidas, se o lançamento falhou).
+func journalEvent(input PostJournalEntryInput, transaction *domain.Transaction, entries []domain.Entry, status string) map[string]interface{} {
+	legs := make([]map[string]interface{}, 0, len(input.Legs))
+	if transaction == nil {
+		for _, leg := range input.Legs {
+			legs = append(legs, map[string]interface{}{"wallet_id": leg.WalletID, "amount": leg.Amount.Amount, "currency": leg.Amount.Currency})
+		}
+	} else {
+		for _, e := range entries {
+			legs = append(legs, map[string]interface{}{"wallet_id": e.WalletID, "amount": e.Amount, "currency": e.Currency})
+		}
+	}
+
+	event := map[string]interface{}{
+		"transaction_id": "",
+		"kind":           domain.TransactionKindJournal,
+		"description":    input.Description,
+		"status":         status,
+		"legs":           legs,
+	}
+	if transaction != nil {
+		event["transaction_id"] = transaction.ID
+		event["amount"] = transaction.Amount
+		event["currency"] = transaction.Currency
+	}
+	return event
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type PurgedIdempotencyKey struct {
+	ClientID string `json:"client_id"`
+	Route    string `json:"route"`
+	Key      string `json:"key"`
+}
+
+type PurgeIdempotencyKeysOutput struct {
+	Purged []PurgedIdempotencyKey `json:"purged"`
+}
+
+// PurgeIdempotencyKeysUseCase apaga as gravações de uma chave (respostas e reservas em andamento):
+// a próxima requisição com ela executa a operação de novo. Para destravar uma chave presa ou
+// liberar a chave de uma operação que o cliente precisa refazer.
+type PurgeIdempotencyKeysUseCase struct {
+	idempotencyRepository gateway.IdempotencyAdminRepository
+}
+
+func NewPurgeIdempotencyKeys(idempotencyRepo gateway.IdempotencyAdminRepository) *PurgeIdempotencyKeysUseCase {
+	return &PurgeIdempotencyKeysUseCase{
+		idempotencyRepository: idempotencyRepo,
+	}
+}
+
+func (u *PurgeIdempotencyKeysUseCase) Execute(ctx context.Context, input IdempotencyKeysInput) (*PurgeIdempotencyKeysOutput, error) {
+	filter, err := toIdempotencyFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := u.idempotencyRepository.Purge(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao expurgar chaves de idempotência: %w", err)
+	}
+	if len(keys) == 0 {
+		return nil, domain.ErrIdempotencyKeyNotFound
+	}
+
+	output := &PurgeIdempotencyKeysOutput{Purged: make([]PurgedIdempotencyKey, 0, len(keys))}
+	for _, key := range keys {
+		output.Purged = append(output.Purged, PurgedIdempotencyKey{ClientID: key.ClientID, Route: key.Route, Key: key.Key})
+	}
+	return output, nil
+}
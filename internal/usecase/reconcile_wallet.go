@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// ReconcileWalletOutput compara o saldo materializado com o saldo reconstruído pelo ledger
+type ReconcileWalletOutput struct {
+	WalletID      int64 `json:"wallet_id"`
+	Balance       int64 `json:"balance"`
+	LedgerBalance int64 `json:"ledger_balance"`
+	Difference    int64 `json:"difference"`
+	Consistent    bool  `json:"consistent"`
+}
+
+// ReconcileWalletUseCase confere se wallets.balance bate com SUM(entries.amount).
+// Qualquer diferença indica uma alteração de saldo feita fora do ledger.
+type ReconcileWalletUseCase struct {
+	walletRepository   gateway.WalletRepository
+	entryRepository    gateway.EntryRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewReconcileWallet(
+	walletRepo gateway.WalletRepository,
+	entryRepo gateway.EntryRepository,
+	txManager gateway.TransactionManager,
+) *ReconcileWalletUseCase {
+	return &ReconcileWalletUseCase{
+		walletRepository:   walletRepo,
+		entryRepository:    entryRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *ReconcileWalletUseCase) Execute(ctx context.Context, walletID int64) (*ReconcileWalletOutput, error) {
+	var output *ReconcileWalletOutput
+
+	// Travamos a carteira para que nenhuma transferência concorrente
+	// altere o saldo entre a leitura do cache e a soma das partidas.
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		wallet, err := u.walletRepository.WithTx(transactionObject).GetByIDForUpdate(contextWithTx, walletID)
+		if err != nil {
+			return err
+		}
+
+		ledgerBalance, err := u.entryRepository.WithTx(transactionObject).LedgerBalance(contextWithTx, walletID)
+		if err != nil {
+			return fmt.Errorf("erro ao reconstruir saldo pelo ledger: %w", err)
+		}
+
+		output = &ReconcileWalletOutput{
+			WalletID:      wallet.ID,
+			Balance:       wallet.Balance,
+			LedgerBalance: ledgerBalance,
+			Difference:    wallet.Balance - ledgerBalance,
+			Consistent:    wallet.Balance == ledgerBalance,
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrWalletNotFound) {
+			return nil, domain.ErrWalletNotFound
+		}
+		return nil, fmt.Errorf("erro ao conciliar carteira: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type RefundTransferInput struct {
+	TransactionID  string       // Transação original
+	Amount         domain.Money // Opcional: 0 estorna todo o saldo ainda estornável
+	IdempotencyKey *string
+	// Escopo (cliente e rota) da IdempotencyKey, ver gateway.IdempotencyKey.Scope
+	IdempotencyScope string
+}
+
+type RefundTransferOutput struct {
+	RefundTransactionID   string `json:"refund_transaction_id"`
+	OriginalTransactionID string `json:"original_transaction_id"`
+	Amount                int64  `json:"amount"`
+	Currency              string `json:"currency"`
+	RefundedAmount        int64  `json:"refunded_amount"` // Total já estornado da original
+	OriginalStatus        string `json:"original_status"` // partially_refunded ou refunded
+}
+
+// RefundTransferUseCase desfaz (total ou parcialmente) uma transferência concluída.
+// O estorno é uma transação compensatória no sentido inverso, ligada à original:
+// nada é apagado do ledger.
+type RefundTransferUseCase struct {
+	transactionRepository gateway.TransactionRepository
+	transferMoney         *TransferMoneyUseCase // Reaproveita a transferência dentro da mesma transação
+	transactionManager    gateway.TransactionManager
+	eventPublisher        gateway.EventPublisher
+}
+
+func NewRefundTransfer(
+	transactionRepo gateway.TransactionRepository,
+	transferMoney *TransferMoneyUseCase,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *RefundTransferUseCase {
+	return &RefundTransferUseCase{
+		transactionRepository: transactionRepo,
+		transferMoney:         transferMoney,
+		transactionManager:    txManager,
+		eventPublisher:        publisher,
+	}
+}
+
+func (u *RefundTransferUseCase) Execute(ctx context.Context, input RefundTransferInput) (*RefundTransferOutput, error) {
+	if input.Amount.Amount < 0 {
+		return nil, domain.ErrInvalidAmount
+	}
+
+	var original, refund *domain.Transaction
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		transactionRepoTx := u.transactionRepository.WithTx(transactionObject)
+
+		// Lock na original: dois estornos parciais em paralelo não podem somar mais que o valor dela
+		var err error
+		original, err = transactionRepoTx.GetByIDForUpdate(contextWithTx, input.TransactionID)
+		if err != nil {
+			return err
+		}
+
+		// Estorno de estorno não existe (faz-se uma nova transferência).
+		// Câmbio também não: o valor devolvido dependeria de uma nova cotação.
+		// Lançamentos journal não têm um par origem/destino para inverter,
+		// e depósitos/saques são desfeitos com a operação inversa.
+		if original.IsRefund() || original.IsCrossCurrency() || !original.IsTransfer() {
+			return domain.ErrNotRefundable
+		}
+		if original.Status != domain.TransactionStatusCompleted && original.Status != domain.TransactionStatusPartiallyRefunded {
+			return domain.ErrNotRefundable
+		}
+
+		// Sem moeda, o valor está na moeda da transação original
+		refunded, err := input.Amount.In(original.Currency)
+		if err != nil {
+			return err
+		}
+		amount := refunded.Amount
+		if amount == 0 {
+			amount = original.RefundableAmount()
+		}
+		if err := original.ApplyRefund(amount); err != nil {
+			return err
+		}
+
+		// Sentido inverso: quem recebeu devolve para quem pagou.
+		// Se o recebedor não tiver mais saldo disponível, o estorno falha com ErrInsufficientFunds.
+		refund, err = u.transferMoney.transfer(contextWithTx, TransferMoneyInput{
+			FromWalletID:          original.ToWalletID,
+			ToWalletID:            original.FromWalletID,
+			Amount:                domain.Money{Amount: amount, Currency: original.Currency},
+			IdempotencyKey:        input.IdempotencyKey,
+			IdempotencyScope:      input.IdempotencyScope,
+			OriginalTransactionID: original.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return transactionRepoTx.UpdateRefund(contextWithTx, original)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if u.eventPublisher != nil {
+		event := transferEvent(TransferMoneyInput{
+			FromWalletID: refund.FromWalletID,
+			ToWalletID:   refund.ToWalletID,
+		}, refund, domain.TransactionStatusRefunded)
+		event["original_transaction_id"] = original.ID
+		event["refunded_amount"] = original.RefundedAmount
+		event["original_status"] = original.Status
+
+		_ = u.eventPublisher.Publish(ctx, "ledger_events", "transaction.refunded", event)
+	}
+
+	return &RefundTransferOutput{
+		RefundTransactionID:   refund.ID,
+		OriginalTransactionID: original.ID,
+		Amount:                refund.Amount,
+		Currency:              string(refund.Currency),
+		RefundedAmount:        original.RefundedAmount,
+		OriginalStatus:        original.Status,
+	}, nil
+}
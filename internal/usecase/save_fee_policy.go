@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type SaveFeePolicyInput struct {
+	Tier            string
+	Currency        string
+	Kind            string
+	FlatAmount      int64
+	PercentageBps   int64
+	MinAmount       *int64
+	MaxAmount       *int64
+	Brackets        []domain.FeeBracket
+	RevenueWalletID *int64
+}
+
+// SaveFeePolicyUseCase cria ou substitui a política de tarifa de uma faixa em uma moeda.
+// A mudança vale na próxima transferência das carteiras da faixa.
+type SaveFeePolicyUseCase struct {
+	walletRepository    gateway.WalletRepository
+	limitRepository     gateway.LimitRepository
+	feePolicyRepository gateway.FeePolicyRepository
+	transactionManager  gateway.TransactionManager
+}
+
+func NewSaveFeePolicy(
+	walletRepo gateway.WalletRepository,
+	limitRepo gateway.LimitRepository,
+	feePolicyRepo gateway.FeePolicyRepository,
+	txManager gateway.TransactionManager,
+) *SaveFeePolicyUseCase {
+	return &SaveFeePolicyUseCase{
+		walletRepository:    walletRepo,
+		limitRepository:     limitRepo,
+		feePolicyRepository: feePolicyRepo,
+		transactionManager:  txManager,
+	}
+}
+
+func (u *SaveFeePolicyUseCase) Execute(ctx context.Context, input SaveFeePolicyInput) (*FeePolicyOutput, error) {
+	currency, err := domain.ParseCurrency(input.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &domain.FeePolicy{
+		Tier:            input.Tier,
+		Currency:        currency,
+		Kind:            input.Kind,
+		FlatAmount:      input.FlatAmount,
+		PercentageBps:   input.PercentageBps,
+		MinAmount:       input.MinAmount,
+		MaxAmount:       input.MaxAmount,
+		Brackets:        input.Brackets,
+		RevenueWalletID: input.RevenueWalletID,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	err = u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		if _, err := u.limitRepository.WithTx(transactionObject).GetTier(contextWithTx, input.Tier); err != nil {
+			if err == domain.ErrLimitTierNotFound {
+				return err
+			}
+			return fmt.Errorf("erro ao buscar faixa de limites: %w", err)
+		}
+
+		// A receita precisa cair numa carteira da casa, na moeda da tarifa (as partidas fecham por moeda)
+		if input.RevenueWalletID != nil {
+			wallet, err := u.walletRepository.WithTx(transactionObject).GetByID(contextWithTx, *input.RevenueWalletID)
+			if err != nil {
+				if err == domain.ErrWalletNotFound {
+					return err
+				}
+				return fmt.Errorf("erro ao buscar carteira de receita: %w", err)
+			}
+			if !wallet.IsSystem() || wallet.Currency != currency {
+				return domain.ErrInvalidRevenueWallet
+			}
+		}
+
+		if err := u.feePolicyRepository.WithTx(transactionObject).Save(contextWithTx, policy); err != nil {
+			return fmt.Errorf("erro ao salvar política de tarifa: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toFeePolicyOutput(policy), nil
+}
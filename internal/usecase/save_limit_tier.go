@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Códigos de faixa: minúsculas, dígitos e "_" (ex: standard, business, private_banking)
+var limitTierCodePattern = regexp.MustCompile(`^[a-z0-9_]{1,30}$`)
+
+type SaveLimitTierInput struct {
+	Code   string
+	Limits domain.VelocityLimits
+}
+
+// SaveLimitTierUseCase cria ou substitui uma faixa de limites.
+// A mudança vale na próxima transferência de todas as carteiras da faixa.
+type SaveLimitTierUseCase struct {
+	limitRepository    gateway.LimitRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewSaveLimitTier(limitRepo gateway.LimitRepository, txManager gateway.TransactionManager) *SaveLimitTierUseCase {
+	return &SaveLimitTierUseCase{
+		limitRepository:    limitRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *SaveLimitTierUseCase) Execute(ctx context.Context, input SaveLimitTierInput) (*LimitTierOutput, error) {
+	if !limitTierCodePattern.MatchString(input.Code) {
+		return nil, domain.ErrInvalidLimitTier
+	}
+	if err := input.Limits.Validate(); err != nil {
+		return nil, err
+	}
+
+	tier := &domain.LimitTier{Code: input.Code, Limits: input.Limits}
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		if err := u.limitRepository.WithTx(transactionObject).SaveTier(contextWithTx, tier); err != nil {
+			return fmt.Errorf("erro ao salvar faixa de limites: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toLimitTierOutput(tier), nil
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// balanceSnapshotSettleDelay: as partidas gravam o início da transação em created_at, então uma
+// transação aberta antes da meia-noite ainda pode estar em andamento logo depois dela
+const balanceSnapshotSettleDelay = 5 * time.Minute
+
+type TakeBalanceSnapshotsOutput struct {
+	Created int64 // Carteiras fotografadas (as sem movimento desde a última fotografia ficam de fora)
+}
+
+// TakeBalanceSnapshotsUseCase grava o saldo de fim de dia das carteiras, usado como ponto de
+// partida pelas consultas de saldo em data passada. Reexecutar para a mesma data não duplica.
+type TakeBalanceSnapshotsUseCase struct {
+	balanceSnapshotRepository gateway.BalanceSnapshotRepository
+}
+
+func NewTakeBalanceSnapshots(snapshotRepo gateway.BalanceSnapshotRepository) *TakeBalanceSnapshotsUseCase {
+	return &TakeBalanceSnapshotsUseCase{
+		balanceSnapshotRepository: snapshotRepo,
+	}
+}
+
+// Execute fotografa a data (dia do calendário de Brasília). O dia precisa ter terminado.
+func (u *TakeBalanceSnapshotsUseCase) Execute(ctx context.Context, date time.Time, now time.Time) (*TakeBalanceSnapshotsOutput, error) {
+	date = domain.BusinessDate(date)
+	closesAt := date.AddDate(0, 0, 1)
+	if closesAt.Add(balanceSnapshotSettleDelay).After(now) {
+		return nil, domain.ErrInvalidSnapshotDate
+	}
+
+	created, err := u.balanceSnapshotRepository.CreateForDate(ctx, date, closesAt)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao fotografar saldos: %w", err)
+	}
+	return &TakeBalanceSnapshotsOutput{Created: created}, nil
+}
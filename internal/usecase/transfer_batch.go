@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Modos de execução de um lote de transferências
+const (
+	BatchModeAllOrNothing = "all_or_nothing" // Uma única transação: qualquer falha desfaz o lote inteiro
+	BatchModeBestEffort   = "best_effort"    // Cada perna na sua transação: o resultado é reportado por perna
+)
+
+// MaxTransferBatchLegs limita o tamanho do lote (e o tempo de lock das carteiras no modo atômico)
+const MaxTransferBatchLegs = 500
+
+// TransferBatchLeg é uma transferência do lote. Lotes não aceitam câmbio:
+// a cotação travaria as carteiras de posição fora da ordem global de lock.
+type TransferBatchLeg struct {
+	FromWalletID int64
+	ToWalletID   int64
+	Amount       domain.Money // A moeda é opcional: vazia, vale a da carteira de origem
+}
+
+type TransferBatchInput struct {
+	Mode           string
+	Legs           []TransferBatchLeg
+	IdempotencyKey *string // Cada perna usa "<chave>:<índice>"
+	// Escopo (cliente e rota) da IdempotencyKey, ver gateway.IdempotencyKey.Scope
+	IdempotencyScope string
+}
+
+type TransferBatchLegResult struct {
+	Index         int
+	TransactionID string
+	Status        string // completed ou failed
+	Err           error  // Preenchido nas pernas que falharam (modo best_effort)
+}
+
+type TransferBatchOutput struct {
+	Mode      string
+	Completed int
+	Failed    int
+	Legs      []TransferBatchLegResult
+}
+
+// TransferBatchLegError identifica a perna que abortou um lote all_or_nothing
+type TransferBatchLegError struct {
+	Index int
+	Err   error
+}
+
+func (e *TransferBatchLegError) Error() string {
+	return fmt.Sprintf("perna %d do lote: %v", e.Index, e.Err)
+}
+
+func (e *TransferBatchLegError) Unwrap() error {
+	return e.Err
+}
+
+// TransferBatchUseCase executa várias transferências em uma chamada (ex: folha de pagamento).
+// As pernas reaproveitam o TransferMoneyUseCase: mesmas validações, partidas e eventos.
+type TransferBatchUseCase struct {
+	walletRepository   gateway.WalletRepository
+	transferMoney      *TransferMoneyUseCase
+	transactionManager gateway.TransactionManager
+	eventPublisher     gateway.EventPublisher
+}
+
+func NewTransferBatch(
+	walletRepo gateway.WalletRepository,
+	transferMoney *TransferMoneyUseCase,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *TransferBatchUseCase {
+	return &TransferBatchUseCase{
+		walletRepository:   walletRepo,
+		transferMoney:      transferMoney,
+		transactionManager: txManager,
+		eventPublisher:     publisher,
+	}
+}
+
+func (u *TransferBatchUseCase) Execute(ctx context.Context, input TransferBatchInput) (*TransferBatchOutput, error) {
+	if len(input.Legs) == 0 || len(input.Legs) > MaxTransferBatchLegs {
+		return nil, domain.ErrInvalidBatch
+	}
+
+	legs := make([]TransferMoneyInput, len(input.Legs))
+	for i, leg := range input.Legs {
+		legs[i] = TransferMoneyInput{
+			FromWalletID: leg.FromWalletID,
+			ToWalletID:   leg.ToWalletID,
+			Amount:       leg.Amount,
+		}
+		if input.IdempotencyKey != nil {
+			key := *input.IdempotencyKey + ":" + strconv.Itoa(i)
+			legs[i].IdempotencyKey = &key
+			legs[i].IdempotencyScope = input.IdempotencyScope
+		}
+	}
+
+	switch input.Mode {
+	case BatchModeAllOrNothing:
+		return u.allOrNothing(ctx, legs)
+	case BatchModeBestEffort:
+		return u.bestEffort(ctx, legs), nil
+	default:
+		return nil, domain.ErrInvalidBatchMode
+	}
+}
+
+// allOrNothing roda todas as pernas em um único Uow.Run.
+// As carteiras de todas as pernas são travadas de uma vez, em ordem crescente de ID, antes da primeira perna:
+// dois lotes com carteiras em comum esperam um pelo outro em vez de gerar Deadlock.
+func (u *TransferBatchUseCase) allOrNothing(ctx context.Context, legs []TransferMoneyInput) (*TransferBatchOutput, error) {
+	walletIDs := make([]int64, 0, 2*len(legs))
+	for i, leg := range legs {
+		if leg.Amount.Amount <= 0 {
+			return nil, &TransferBatchLegError{Index: i, Err: domain.ErrInvalidAmount}
+		}
+		walletIDs = append(walletIDs, leg.FromWalletID, leg.ToWalletID)
+	}
+
+	transactions := make([]*domain.Transaction, len(legs))
+	failedLeg := -1
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		walletRepoTx := u.walletRepository.WithTx(contextWithTx.Value(gateway.TransactionKey))
+		if _, err := lockWallets(contextWithTx, walletRepoTx, walletIDs...); err != nil {
+			return err
+		}
+
+		// transfer trava de novo as duas carteiras da perna: no mesmo tx, o lock já é nosso
+		for i, leg := range legs {
+			transaction, err := u.transferMoney.transfer(contextWithTx, leg)
+			if err != nil {
+				failedLeg = i
+				return &TransferBatchLegError{Index: i, Err: err}
+			}
+			transactions[i] = transaction
+		}
+		return nil
+	})
+
+	// Eventos só depois do Commit (ou Rollback): nada é publicado para pernas desfeitas
+	if u.eventPublisher != nil {
+		if err != nil {
+			if failedLeg >= 0 {
+				event := transferEvent(legs[failedLeg], nil, domain.TransactionStatusFailed)
+				_ = u.eventPublisher.Publish(ctx, "ledger_events", "transaction."+domain.TransactionStatusFailed, event)
+			}
+		} else {
+			for i, transaction := range transactions {
+				event := transferEvent(legs[i], transaction, transaction.Status)
+				_ = u.eventPublisher.Publish(ctx, "ledger_events", "transaction."+transaction.Status, event)
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &TransferBatchOutput{Mode: BatchModeAllOrNothing, Completed: len(transactions)}
+	for i, transaction := range transactions {
+		output.Legs = append(output.Legs, TransferBatchLegResult{
+			Index:         i,
+			TransactionID: transaction.ID,
+			Status:        transaction.Status,
+		})
+	}
+	return output, nil
+}
+
+// bestEffort executa cada perna na sua própria transação, em ordem.
+// Cada uma trava só as suas duas carteiras (ordenadas pelo TransferMoneyUseCase).
+func (u *TransferBatchUseCase) bestEffort(ctx context.Context, legs []TransferMoneyInput) *TransferBatchOutput {
+	output := &TransferBatchOutput{Mode: BatchModeBestEffort}
+	for i, leg := range legs {
+		result := TransferBatchLegResult{Index: i}
+
+		transfer, err := u.transferMoney.Execute(ctx, leg)
+		if err != nil {
+			result.Status = domain.TransactionStatusFailed
+			result.Err = err
+			output.Failed++
+		} else {
+			result.TransactionID = transfer.TransactionID
+			result.Status = transfer.Status
+			output.Completed++
+		}
+		output.Legs = append(output.Legs, result)
+	}
+	return output
+}
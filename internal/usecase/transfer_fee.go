@@ -0,0 +1,50 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// transferFee calcula a tarifa de uma saída de 'amount' pela política da faixa da carteira na moeda dela.
+// Retorna a tarifa e a carteira que a recebe; sem política (ou com tarifa zero), a carteira é nil.
+func transferFee(
+	ctx context.Context,
+	limitRepo gateway.LimitRepository,
+	feePolicyRepo gateway.FeePolicyRepository,
+	walletRepo gateway.WalletRepository,
+	wallet *domain.Wallet,
+	amount int64,
+) (int64, *domain.Wallet, error) {
+	walletLimits, err := limitRepo.GetWalletLimits(ctx, wallet.ID)
+	if err != nil {
+		return 0, nil, fmt.Errorf("falha ao buscar faixa da carteira %d: %w", wallet.ID, err)
+	}
+
+	policy, err := feePolicyRepo.Get(ctx, walletLimits.Tier, wallet.Currency)
+	if err != nil {
+		if errors.Is(err, domain.ErrFeePolicyNotFound) {
+			return 0, nil, nil
+		}
+		return 0, nil, fmt.Errorf("falha ao buscar política de tarifa: %w", err)
+	}
+
+	fee := policy.Calculate(amount)
+	if fee == 0 {
+		return 0, nil, nil
+	}
+
+	var revenueWallet *domain.Wallet
+	if policy.RevenueWalletID != nil {
+		revenueWallet, err = walletRepo.GetByID(ctx, *policy.RevenueWalletID)
+	} else {
+		revenueWallet, err = walletRepo.GetSystemWallet(ctx, domain.SystemWalletFeeRevenue, wallet.Currency)
+	}
+	if err != nil {
+		return 0, nil, fmt.Errorf("carteira de receita de tarifas %s não configurada: %w", wallet.Currency, err)
+	}
+	return fee, revenueWallet, nil
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// Carteira de sistema fee_revenue devolvida pelo repositório falso
+const feeTestSystemRevenueWalletID = 900
+
+func TestTransferFee(t *testing.T) {
+	brackets := []domain.FeeBracket{
+		{UpTo: int64Ptr(10_000), Fee: 0},
+		{UpTo: int64Ptr(100_000), Fee: 150},
+		{UpTo: nil, Fee: 500},
+	}
+	tiered := &domain.FeePolicy{Kind: domain.FeeKindTiered, Brackets: brackets}
+	closedTiered := &domain.FeePolicy{Kind: domain.FeeKindTiered, Brackets: brackets[:2]}
+	percentage := &domain.FeePolicy{Kind: domain.FeeKindPercentage, PercentageBps: 150, MinAmount: int64Ptr(100), MaxAmount: int64Ptr(1_000)}
+	uncapped := &domain.FeePolicy{Kind: domain.FeeKindPercentage, PercentageBps: 100}
+	flatToOwnWallet := &domain.FeePolicy{Kind: domain.FeeKindFlat, FlatAmount: 250, RevenueWalletID: int64Ptr(77)}
+
+	tests := []struct {
+		name        string
+		policy      *domain.FeePolicy // nil = sem política para a faixa e moeda
+		amount      int64
+		wantFee     int64
+		wantRevenue int64 // ID da carteira que recebe; 0 = nenhuma
+	}{
+		{name: "sem política", amount: 50_000},
+		{name: "faixa gratuita até o teto, inclusive", policy: tiered, amount: 10_000},
+		{name: "um centavo acima do teto muda de faixa", policy: tiered, amount: 10_001, wantFee: 150, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "teto da segunda faixa", policy: tiered, amount: 100_000, wantFee: 150, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "faixa sem teto", policy: tiered, amount: 100_001, wantFee: 500, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "acima da última faixa com teto vale a última", policy: closedTiered, amount: 5_000_000, wantFee: 150, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "percentual abaixo do mínimo", policy: percentage, amount: 1_000, wantFee: 100, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "percentual entre mínimo e máximo", policy: percentage, amount: 50_000, wantFee: 750, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "percentual acima do máximo", policy: percentage, amount: 1_000_000, wantFee: 1_000, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "meio centavo arredonda para cima", policy: uncapped, amount: 50, wantFee: 1, wantRevenue: feeTestSystemRevenueWalletID},
+		{name: "menos de meio centavo não cobra", policy: uncapped, amount: 49},
+		{name: "carteira de receita da política", policy: flatToOwnWallet, amount: 1, wantFee: 250, wantRevenue: 77},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wallet := &domain.Wallet{ID: 1, Currency: domain.CurrencyBRL}
+			fee, revenueWallet, err := transferFee(
+				context.Background(),
+				feeTestLimitRepository{},
+				feeTestPolicyRepository{policy: tt.policy},
+				feeTestWalletRepository{},
+				wallet,
+				tt.amount,
+			)
+			if err != nil {
+				t.Fatalf("transferFee() error = %v", err)
+			}
+			if fee != tt.wantFee {
+				t.Errorf("fee = %d, want %d", fee, tt.wantFee)
+			}
+
+			var revenueID int64
+			if revenueWallet != nil {
+				revenueID = revenueWallet.ID
+			}
+			if revenueID != tt.wantRevenue {
+				t.Errorf("revenue wallet = %d, want %d", revenueID, tt.wantRevenue)
+			}
+		})
+	}
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+type feeTestLimitRepository struct {
+	gateway.LimitRepository
+}
+
+func (feeTestLimitRepository) GetWalletLimits(_ context.Context, walletID int64) (*domain.WalletLimits, error) {
+	return &domain.WalletLimits{WalletID: walletID, Tier: "standard"}, nil
+}
+
+type feeTestPolicyRepository struct {
+	gateway.FeePolicyRepository
+	policy *domain.FeePolicy
+}
+
+func (r feeTestPolicyRepository) Get(_ context.Context, tier string, currency domain.Currency) (*domain.FeePolicy, error) {
+	if r.policy == nil {
+		return nil, domain.ErrFeePolicyNotFound
+	}
+	policy := *r.policy
+	policy.Tier, policy.Currency = tier, currency
+	return &policy, nil
+}
+
+type feeTestWalletRepository struct {
+	gateway.WalletRepository
+}
+
+func (feeTestWalletRepository) GetByID(_ context.Context, id int64) (*domain.Wallet, error) {
+	return &domain.Wallet{ID: id, Currency: domain.CurrencyBRL}, nil
+}
+
+func (feeTestWalletRepository) GetSystemWallet(_ context.Context, _ string, currency domain.Currency) (*domain.Wallet, error) {
+	return &domain.Wallet{ID: feeTestSystemRevenueWalletID, Currency: currency}, nil
+}
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
 	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
@@ -13,20 +14,31 @@ import (
 type TransferMoneyInput struct {
 	FromWalletID   int64
 	ToWalletID     int64
-	Amount         int64 // Valor em centavos (ex: 1000 = R$ 10,00)
+	Amount         domain.Money // Na moeda de origem; a moeda é opcional (vazia = a da carteira de origem)
+	QuoteID        string       // Opcional: cotação de câmbio travada (obrigatória entre moedas diferentes)
 	IdempotencyKey *string
+	// Escopo (cliente e rota) da IdempotencyKey, ver gateway.IdempotencyKey.Scope
+	IdempotencyScope string
+
+	// Preenchido apenas por estornos (não exposto na API de transferências)
+	OriginalTransactionID string
 }
 
 // TransferMoneyOutput define o que devolvemos para quem chamou.
 type TransferMoneyOutput struct {
 	TransactionID string
 	Status        string
+	FeeAmount     int64
 }
 
 // TransferMoneyUseCase contém as dependências necessárias.
 type TransferMoneyUseCase struct {
 	walletRepository      gateway.WalletRepository
 	transactionRepository gateway.TransactionRepository
+	entryRepository       gateway.EntryRepository
+	fxQuoteRepository     gateway.FXQuoteRepository
+	limitRepository       gateway.LimitRepository
+	feePolicyRepository   gateway.FeePolicyRepository
 	transactionManager    gateway.TransactionManager // Nosso "Unit of Work"
 	eventPublisher        gateway.EventPublisher
 }
@@ -35,12 +47,20 @@ type TransferMoneyUseCase struct {
 func NewTransferMoney(
 	walletRepo gateway.WalletRepository,
 	transactionRepo gateway.TransactionRepository,
+	entryRepo gateway.EntryRepository,
+	fxQuoteRepo gateway.FXQuoteRepository,
+	limitRepo gateway.LimitRepository,
+	feePolicyRepo gateway.FeePolicyRepository,
 	txManager gateway.TransactionManager,
 	publisher gateway.EventPublisher,
 ) *TransferMoneyUseCase {
 	return &TransferMoneyUseCase{
 		walletRepository:      walletRepo,
 		transactionRepository: transactionRepo,
+		entryRepository:       entryRepo,
+		fxQuoteRepository:     fxQuoteRepo,
+		limitRepository:       limitRepo,
+		feePolicyRepository:   feePolicyRepo,
 		transactionManager:    txManager,
 		eventPublisher:        publisher,
 	}
@@ -50,106 +70,232 @@ func NewTransferMoney(
 func (u *TransferMoneyUseCase) Execute(ctx context.Context, input TransferMoneyInput) (*TransferMoneyOutput, error) {
 	// Variável para capturar o resultado de dentro da transação
 	var createdTransaction *domain.Transaction
-	// Variáveis para o evento
-	transactionStatus := "failed"
-	var createdTransactionID string
+	// Variável para o evento
+	transactionStatus := domain.TransactionStatusFailed
 
 	// Isso roda SEMPRE antes da função retornar, seja sucesso ou erro.
 	defer func() {
 		if u.eventPublisher != nil {
-			event := map[string]interface{}{
-				"transaction_id": createdTransactionID, // Pode estar vazio se falhar antes de criar
-				"from_wallet":    input.FromWalletID,
-				"to_wallet":      input.ToWalletID,
-				"amount":         input.Amount,
-				"status":         transactionStatus,
-				"reason":         "", // Poderíamos adicionar a razão do erro aqui
-			}
+			event := transferEvent(input, createdTransaction, transactionStatus)
 
 			// Define o tópico baseado no status
-			routingKey := "transaction." + transactionStatus // transaction.created ou transaction.failed
+			routingKey := "transaction." + transactionStatus // transaction.completed ou transaction.failed
 
 			_ = u.eventPublisher.Publish(ctx, "ledger_events", routingKey, event)
 		}
 	}()
 
+	// Com cotação, o valor pode ser omitido: usamos o valor cotado
+	if input.Amount.Amount < 0 || (input.Amount.Amount == 0 && input.QuoteID == "") {
+		return nil, domain.ErrInvalidAmount
+	}
+
 	// u.transactionManager.Run inicia uma transação no banco (BEGIN).
 	// Se a função anônima retornar erro, ele faz ROLLBACK automático.
 	// Se retornar nil, ele faz COMMIT.
 	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		var err error
+		createdTransaction, err = u.transfer(contextWithTx, input)
+		return err
+	})
 
-		// Recuperar o "crachá" da transação que está dentro do contexto.
-		// Isso foi injetado pelo TransactionManager.Run
-		transactionObject := contextWithTx.Value(gateway.TransactionKey)
-		if transactionObject == nil {
-			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
-		}
+	if err != nil {
+		return nil, err
+	}
+	transactionStatus = createdTransaction.Status
 
-		// Criar cópias dos repositórios que usam ESSA transação específica.
-		// Agora, qualquer comando dado a 'walletRepoTx' rodará dentro do 'BEGIN...COMMIT'.
-		walletRepoTx := u.walletRepository.WithTx(transactionObject)
-		transactionRepoTx := u.transactionRepository.WithTx(transactionObject)
-
-		// Ordenação de IDs para evitar Deadlock (Lock Pessimista)
-		// Se a Transferência A->B e B->A acontecerem ao mesmo tempo,
-		// ordenamos para que ambas travem sempre o ID menor primeiro.
-		firstID, secondID := input.FromWalletID, input.ToWalletID
-		if firstID > secondID {
-			firstID, secondID = secondID, firstID
-		}
+	return &TransferMoneyOutput{
+		TransactionID: createdTransaction.ID,
+		Status:        createdTransaction.Status,
+		FeeAmount:     createdTransaction.FeeAmount,
+	}, nil
+}
 
-		// Lock nas Carteiras (SELECT ... FOR UPDATE)
-		// Isso faz o banco TRAVAR essas linhas. Ninguém mais mexe nelas até o Commit.
-		_, err := walletRepoTx.GetByIDForUpdate(contextWithTx, firstID)
-		if err != nil {
-			return fmt.Errorf("falha ao travar carteira %d: %w", firstID, err)
-		}
+// transfer move o dinheiro dentro de uma transação já aberta (o contexto precisa carregar o tx).
+func (u *TransferMoneyUseCase) transfer(contextWithTx context.Context, input TransferMoneyInput) (*domain.Transaction, error) {
+	// Recuperar o "crachá" da transação que está dentro do contexto.
+	// Isso foi injetado pelo TransactionManager.Run
+	transactionObject := contextWithTx.Value(gateway.TransactionKey)
+	if transactionObject == nil {
+		return nil, fmt.Errorf("erro crítico: transação não encontrada no contexto")
+	}
+
+	// Criar cópias dos repositórios que usam ESSA transação específica.
+	// Agora, qualquer comando dado a 'walletRepoTx' rodará dentro do 'BEGIN...COMMIT'.
+	walletRepoTx := u.walletRepository.WithTx(transactionObject)
+	transactionRepoTx := u.transactionRepository.WithTx(transactionObject)
+	entryRepoTx := u.entryRepository.WithTx(transactionObject)
+	fxQuoteRepoTx := u.fxQuoteRepository.WithTx(transactionObject)
 
-		_, err = walletRepoTx.GetByIDForUpdate(contextWithTx, secondID)
+	walletIDs := []int64{input.FromWalletID, input.ToWalletID}
+
+	// Câmbio: a cotação é travada antes das carteiras para que não seja executada duas vezes.
+	// As carteiras de posição de câmbio das duas moedas entram no lock.
+	var quote *domain.FXQuote
+	var sourcePosition, destinationPosition *domain.Wallet
+	if input.QuoteID != "" {
+		var err error
+		quote, err = fxQuoteRepoTx.GetByIDForUpdate(contextWithTx, input.QuoteID)
 		if err != nil {
-			return fmt.Errorf("falha ao travar carteira %d: %w", secondID, err)
+			return nil, err
+		}
+		if quote.UsedAt != nil {
+			return nil, domain.ErrQuoteAlreadyUsed
 		}
+		if quote.IsExpired(time.Now()) {
+			return nil, domain.ErrQuoteExpired
+		}
+		if input.Amount.Amount != 0 && input.Amount.Amount != quote.SourceAmount {
+			return nil, domain.ErrQuoteMismatch
+		}
+		input.Amount.Amount = quote.SourceAmount
 
-		// Operação de Débito (Quem envia)
-		// O método Debit do repositório já verifica se tem saldo (balance >= amount).
-		err = walletRepoTx.Debit(contextWithTx, input.FromWalletID, input.Amount)
+		sourcePosition, err = walletRepoTx.GetSystemWallet(contextWithTx, domain.SystemWalletFXPosition, quote.SourceCurrency)
 		if err != nil {
-			// Se falhar (saldo insuficiente), retornamos erro e o txManager faz Rollback.
-			return fmt.Errorf("falha no débito (origem %d): %w", input.FromWalletID, err)
+			return nil, fmt.Errorf("posição de câmbio %s não configurada: %w", quote.SourceCurrency, err)
 		}
-
-		// Operação de Crédito (Quem recebe)
-		err = walletRepoTx.Credit(contextWithTx, input.ToWalletID, input.Amount)
+		destinationPosition, err = walletRepoTx.GetSystemWallet(contextWithTx, domain.SystemWalletFXPosition, quote.DestinationCurrency)
 		if err != nil {
-			return fmt.Errorf("falha no crédito (destino %d): %w", input.ToWalletID, err)
+			return nil, fmt.Errorf("posição de câmbio %s não configurada: %w", quote.DestinationCurrency, err)
 		}
+		walletIDs = append(walletIDs, sourcePosition.ID, destinationPosition.ID)
+	}
 
-		// Registrar o Histórico (Auditoria)
-		createdTransaction = &domain.Transaction{
-			FromWalletID:   input.FromWalletID,
-			ToWalletID:     input.ToWalletID,
-			Amount:         input.Amount,
-			Status:         "completed", // Sucesso!
-			IdempotencyKey: input.IdempotencyKey,
+	// Lock nas Carteiras (SELECT ... FOR UPDATE), ordenado por ID para evitar Deadlock.
+	// Isso faz o banco TRAVAR essas linhas. Ninguém mais mexe nelas até o Commit.
+	wallets, err := lockWallets(contextWithTx, walletRepoTx, walletIDs...)
+	if err != nil {
+		return nil, err
+	}
+	fromWallet, toWallet := wallets[input.FromWalletID], wallets[input.ToWalletID]
+
+	// Carteiras de sistema só são movimentadas pelo próprio ledger
+	if fromWallet.IsSystem() || toWallet.IsSystem() {
+		return nil, domain.ErrSystemWallet
+	}
+	// Status checado com as linhas travadas: um congelamento concorrente espera esta transação
+	if err := fromWallet.CanDebit(); err != nil {
+		return nil, err
+	}
+	if err := toWallet.CanCredit(); err != nil {
+		return nil, err
+	}
+	amount, err := input.Amount.In(fromWallet.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	// Registrar a Transação (cabeçalho do lançamento)
+	// Precisa existir antes das partidas, que a referenciam.
+	transaction := &domain.Transaction{
+		FromWalletID:     input.FromWalletID,
+		ToWalletID:       input.ToWalletID,
+		Amount:           amount.Amount,
+		Currency:         amount.Currency,
+		Status:           domain.TransactionStatusCompleted, // Sucesso!
+		IdempotencyKey:   input.IdempotencyKey,
+		IdempotencyScope: input.IdempotencyScope,
+
+		OriginalTransactionID: input.OriginalTransactionID,
+	}
+
+	if quote == nil {
+		// Sem cotação, transferências só acontecem entre carteiras da mesma moeda
+		if fromWallet.Currency != toWallet.Currency {
+			return nil, domain.ErrCurrencyMismatch
 		}
+	} else {
+		if fromWallet.Currency != quote.SourceCurrency || toWallet.Currency != quote.DestinationCurrency {
+			return nil, domain.ErrQuoteMismatch
+		}
+		if err := fxQuoteRepoTx.MarkUsed(contextWithTx, quote.ID); err != nil {
+			return nil, err
+		}
+		transaction.DestinationAmount = quote.DestinationAmount
+		transaction.DestinationCurrency = quote.DestinationCurrency
+		transaction.FXRate = quote.Rate
+		transaction.FXQuoteID = quote.ID
+	}
 
-		err = transactionRepoTx.Create(contextWithTx, createdTransaction)
+	// Limites de movimentação (compliance) e tarifa. Estornos devolvem dinheiro já transferido:
+	// não consomem limite nem pagam tarifa. O limite considera apenas o valor, sem a tarifa.
+	var revenueWallet *domain.Wallet
+	if input.OriginalTransactionID == "" {
+		limitRepoTx := u.limitRepository.WithTx(transactionObject)
+		if err := checkVelocityLimits(contextWithTx, limitRepoTx, fromWallet.ID, transaction.Amount, time.Now()); err != nil {
+			return nil, err
+		}
+		transaction.FeeAmount, revenueWallet, err = transferFee(contextWithTx, limitRepoTx, u.feePolicyRepository.WithTx(transactionObject), walletRepoTx, fromWallet, transaction.Amount)
 		if err != nil {
-			return fmt.Errorf("falha ao salvar histórico da transação: %w", err)
+			return nil, err
 		}
+	}
 
-		createdTransactionID = createdTransaction.ID
-		transactionStatus = "completed"
+	if err := transactionRepoTx.Create(contextWithTx, transaction); err != nil {
+		return nil, fmt.Errorf("falha ao salvar histórico da transação: %w", err)
+	}
 
-		return nil // Sucesso! O Commit será executado agora.
-	})
+	// Partidas Dobradas: débito em quem envia (valor + tarifa numa única partida), crédito em quem recebe.
+	// Se faltar saldo, retornamos erro e o txManager faz Rollback.
+	entries := []domain.Entry{
+		{TransactionID: transaction.ID, WalletID: fromWallet.ID, Amount: -(transaction.Amount + transaction.FeeAmount), Currency: fromWallet.Currency},
+	}
+	if quote == nil {
+		entries = append(entries,
+			domain.Entry{TransactionID: transaction.ID, WalletID: toWallet.ID, Amount: transaction.Amount, Currency: toWallet.Currency},
+		)
+	} else {
+		// Com câmbio, cada moeda fecha em zero passando pelas posições da casa:
+		// origem -> posição (moeda de origem) e posição (moeda de destino) -> destino
+		entries = append(entries,
+			domain.Entry{TransactionID: transaction.ID, WalletID: sourcePosition.ID, Amount: transaction.Amount, Currency: quote.SourceCurrency},
+			domain.Entry{TransactionID: transaction.ID, WalletID: destinationPosition.ID, Amount: -quote.DestinationAmount, Currency: quote.DestinationCurrency},
+			domain.Entry{TransactionID: transaction.ID, WalletID: toWallet.ID, Amount: quote.DestinationAmount, Currency: quote.DestinationCurrency},
+		)
+	}
 
-	if err != nil {
+	// A receita de tarifas é creditada por último: fica fora do lock ordenado (é disputada por
+	// todas as transferências) e só é tocada depois que o débito do remetente passou.
+	if revenueWallet != nil {
+		entries = append(entries,
+			domain.Entry{TransactionID: transaction.ID, WalletID: revenueWallet.ID, Amount: transaction.FeeAmount, Currency: fromWallet.Currency},
+		)
+	}
+
+	if err := postEntries(contextWithTx, walletRepoTx, entryRepoTx, entries); err != nil {
 		return nil, err
 	}
 
-	return &TransferMoneyOutput{
-		TransactionID: createdTransaction.ID,
-		Status:        createdTransaction.Status,
-	}, nil
+	return transaction, nil // Sucesso! O Commit será executado por quem abriu a transação.
+}
+
+// transferEvent monta o payload publicado em ledger_events.
+// transaction pode ser nil se a transferência falhou antes de ser criada.
+func transferEvent(input TransferMoneyInput, transaction *domain.Transaction, status string) map[string]interface{} {
+	event := map[string]interface{}{
+		"transaction_id": "",
+		"from_wallet":    input.FromWalletID,
+		"to_wallet":      input.ToWalletID,
+		"amount":         input.Amount.Amount,
+		"currency":       input.Amount.Currency,
+		"status":         status,
+		"reason":         "", // Poderíamos adicionar a razão do erro aqui
+	}
+
+	if transaction == nil {
+		return event
+	}
+
+	event["transaction_id"] = transaction.ID
+	event["amount"] = transaction.Amount
+	event["currency"] = transaction.Currency
+	event["fee_amount"] = transaction.FeeAmount
+	if transaction.IsCrossCurrency() {
+		event["source_amount"] = transaction.Amount
+		event["destination_amount"] = transaction.DestinationAmount
+		event["destination_currency"] = transaction.DestinationCurrency
+		event["fx_rate"] = transaction.FXRate.FloatString(domain.RatePrecision)
+		event["fx_quote_id"] = transaction.FXQuoteID
+	}
+	return event
 }
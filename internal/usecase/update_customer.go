@@ -0,0 +1,87 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// UpdateCustomerInput traz só os campos alterados (nil = mantém).
+// O documento não muda: um CPF/CNPJ diferente é outro cliente.
+type UpdateCustomerInput struct {
+	CustomerID int64
+	Name       *string
+	Email      *string
+	Phone      *string // "" remove o telefone
+	KYCStatus  *string // Só pela rota administrativa
+}
+
+type UpdateCustomerUseCase struct {
+	customerRepository gateway.CustomerRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewUpdateCustomer(customerRepo gateway.CustomerRepository, txManager gateway.TransactionManager) *UpdateCustomerUseCase {
+	return &UpdateCustomerUseCase{
+		customerRepository: customerRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *UpdateCustomerUseCase) Execute(ctx context.Context, input UpdateCustomerInput) (*CustomerOutput, error) {
+	var output *CustomerOutput
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		var err error
+		output, err = u.update(contextWithTx, u.customerRepository.WithTx(transactionObject), input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// update lê, altera e grava o cliente com o repositório da transação
+func (u *UpdateCustomerUseCase) update(ctx context.Context, customerRepository gateway.CustomerRepository, input UpdateCustomerInput) (*CustomerOutput, error) {
+	customer, err := customerRepository.GetByID(ctx, input.CustomerID)
+	if err != nil {
+		if err == domain.ErrCustomerNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar cliente: %w", err)
+	}
+
+	if input.Name != nil {
+		customer.Name = strings.TrimSpace(*input.Name)
+	}
+	if input.Email != nil {
+		customer.Email = strings.TrimSpace(*input.Email)
+	}
+	if input.Phone != nil {
+		if customer.Phone, err = domain.NormalizePhone(*input.Phone); err != nil {
+			return nil, err
+		}
+	}
+	if input.KYCStatus != nil {
+		customer.KYCStatus = *input.KYCStatus
+	}
+	if err := customer.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := customerRepository.Update(ctx, customer); err != nil {
+		if err == domain.ErrCustomerNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao atualizar cliente: %w", err)
+	}
+	return toCustomerOutput(customer), nil
+}
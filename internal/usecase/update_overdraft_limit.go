@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type UpdateOverdraftLimitInput struct {
+	WalletID       int64
+	OverdraftLimit int64
+	Reason         string
+	// ChangedBy identifica o operador que fez a alteração (vai para a trilha de auditoria)
+	ChangedBy string
+}
+
+type WalletLimitChangeOutput struct {
+	ID            int64  `json:"id"`
+	WalletID      int64  `json:"wallet_id"`
+	PreviousLimit int64  `json:"previous_limit"`
+	NewLimit      int64  `json:"new_limit"`
+	ChangedBy     string `json:"changed_by"`
+	Reason        string `json:"reason"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// UpdateOverdraftLimitUseCase altera a linha de crédito de uma carteira de cliente.
+// A alteração e o registro de auditoria são gravados na mesma transação.
+type UpdateOverdraftLimitUseCase struct {
+	walletRepository      gateway.WalletRepository
+	limitChangeRepository gateway.WalletLimitChangeRepository
+	transactionManager    gateway.TransactionManager
+}
+
+func NewUpdateOverdraftLimit(
+	walletRepo gateway.WalletRepository,
+	limitChangeRepo gateway.WalletLimitChangeRepository,
+	txManager gateway.TransactionManager,
+) *UpdateOverdraftLimitUseCase {
+	return &UpdateOverdraftLimitUseCase{
+		walletRepository:      walletRepo,
+		limitChangeRepository: limitChangeRepo,
+		transactionManager:    txManager,
+	}
+}
+
+func (u *UpdateOverdraftLimitUseCase) Execute(ctx context.Context, input UpdateOverdraftLimitInput) (*WalletLimitChangeOutput, error) {
+	reason := strings.TrimSpace(input.Reason)
+	if reason == "" {
+		return nil, domain.ErrInvalidLimitReason
+	}
+	if input.OverdraftLimit < 0 {
+		return nil, domain.ErrInvalidOverdraftLimit
+	}
+
+	var change *domain.WalletLimitChange
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+
+		// Trava a carteira: um débito concorrente não pode levar o saldo abaixo do novo limite
+		wallet, err := walletRepoTx.GetByIDForUpdate(contextWithTx, input.WalletID)
+		if err != nil {
+			return err
+		}
+		// Carteiras de sistema já podem ficar negativas; limite não se aplica a elas
+		if wallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+		if wallet.Status == domain.WalletStatusClosed {
+			return domain.ErrWalletClosed
+		}
+
+		previousLimit := wallet.OverdraftLimit
+		if err := wallet.SetOverdraftLimit(input.OverdraftLimit); err != nil {
+			return err
+		}
+		if err := walletRepoTx.UpdateOverdraftLimit(contextWithTx, wallet.ID, wallet.OverdraftLimit); err != nil {
+			return err
+		}
+
+		change = &domain.WalletLimitChange{
+			WalletID:      wallet.ID,
+			PreviousLimit: previousLimit,
+			NewLimit:      wallet.OverdraftLimit,
+			ChangedBy:     input.ChangedBy,
+			Reason:        reason,
+		}
+		return u.limitChangeRepository.WithTx(transactionObject).Create(contextWithTx, change)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toWalletLimitChangeOutput(change), nil
+}
+
+func toWalletLimitChangeOutput(change *domain.WalletLimitChange) *WalletLimitChangeOutput {
+	return &WalletLimitChangeOutput{
+		ID:            change.ID,
+		WalletID:      change.WalletID,
+		PreviousLimit: change.PreviousLimit,
+		NewLimit:      change.NewLimit,
+		ChangedBy:     change.ChangedBy,
+		Reason:        change.Reason,
+		CreatedAt:     change.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// UpdateStandingOrderInput traz só os campos alterados (nil = mantém).
+// A recorrência não muda: para outra regra, cancele e crie uma nova ordem.
+type UpdateStandingOrderInput struct {
+	OrderID                 string
+	Amount                  *int64
+	InsufficientFundsPolicy *string
+	MaxRetries              *int32
+	RetryInterval           *time.Duration
+	Status                  *string // active (retoma) ou paused (pausa)
+}
+
+type UpdateStandingOrderUseCase struct {
+	orderRepository    gateway.StandingOrderRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewUpdateStandingOrder(orderRepo gateway.StandingOrderRepository, txManager gateway.TransactionManager) *UpdateStandingOrderUseCase {
+	return &UpdateStandingOrderUseCase{
+		orderRepository:    orderRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *UpdateStandingOrderUseCase) Execute(ctx context.Context, input UpdateStandingOrderInput) (*StandingOrderOutput, error) {
+	var output *StandingOrderOutput
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+
+		var err error
+		output, err = u.update(contextWithTx, u.orderRepository.WithTx(transactionObject), input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// update lê, altera e grava a ordem com o repositório da transação
+func (u *UpdateStandingOrderUseCase) update(ctx context.Context, orderRepository gateway.StandingOrderRepository, input UpdateStandingOrderInput) (*StandingOrderOutput, error) {
+	order, err := orderRepository.GetByID(ctx, input.OrderID)
+	if err != nil {
+		if err == domain.ErrOrderNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao buscar ordem permanente: %w", err)
+	}
+	// Ordens canceladas ou concluídas são histórico
+	if order.Status != domain.StandingOrderStatusActive && order.Status != domain.StandingOrderStatusPaused {
+		return nil, domain.ErrOrderNotActive
+	}
+
+	if input.Amount != nil {
+		if *input.Amount <= 0 {
+			return nil, domain.ErrInvalidAmount
+		}
+		order.Amount = *input.Amount
+	}
+	if input.InsufficientFundsPolicy != nil {
+		order.InsufficientFundsPolicy = *input.InsufficientFundsPolicy
+	}
+	if input.MaxRetries != nil {
+		order.MaxRetries = *input.MaxRetries
+	}
+	if input.RetryInterval != nil {
+		order.RetryInterval = *input.RetryInterval
+	}
+	if err := order.ValidatePolicy(); err != nil {
+		return nil, err
+	}
+
+	if input.Status != nil && *input.Status != order.Status {
+		switch *input.Status {
+		case domain.StandingOrderStatusPaused:
+			order.Status = domain.StandingOrderStatusPaused
+		case domain.StandingOrderStatusActive:
+			// Retomar não executa o que venceu durante a pausa: a série segue da próxima data
+			order.Status = domain.StandingOrderStatusActive
+			order.ScheduleFrom(time.Now().UTC())
+		default:
+			return nil, domain.ErrInvalidOrderStatus
+		}
+	}
+
+	if err := orderRepository.Update(ctx, order); err != nil {
+		return nil, fmt.Errorf("erro ao atualizar ordem permanente: %w", err)
+	}
+	return toStandingOrderOutput(order), nil
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type UpdateWalletInterestInput struct {
+	WalletID   int64
+	AnnualRate string // Decimal: "0.1075" = 10,75% a.a.
+	DayCount   string // Opcional: ACT/365 por padrão
+}
+
+// UpdateWalletInterestUseCase transforma a carteira em poupança ou muda a taxa dela.
+// Na primeira configuração, a carteira passa a render a partir do dia corrente;
+// mudanças de taxa valem para os dias ainda não rendidos (cada dia guarda a taxa usada).
+type UpdateWalletInterestUseCase struct {
+	walletRepository   gateway.WalletRepository
+	interestRepository gateway.InterestRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewUpdateWalletInterest(walletRepo gateway.WalletRepository, interestRepo gateway.InterestRepository, txManager gateway.TransactionManager) *UpdateWalletInterestUseCase {
+	return &UpdateWalletInterestUseCase{
+		walletRepository:   walletRepo,
+		interestRepository: interestRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *UpdateWalletInterestUseCase) Execute(ctx context.Context, input UpdateWalletInterestInput) (*WalletInterestOutput, error) {
+	rate, ok := new(big.Rat).SetString(input.AnnualRate)
+	if !ok {
+		return nil, domain.ErrInvalidInterestRate
+	}
+	account := &domain.InterestAccount{
+		WalletID:   input.WalletID,
+		AnnualRate: rate,
+		DayCount:   input.DayCount,
+		StartDate:  domain.BusinessDate(time.Now()),
+	}
+	if account.DayCount == "" {
+		account.DayCount = domain.DayCountACT365
+	}
+	if err := account.Validate(); err != nil {
+		return nil, err
+	}
+
+	var output *WalletInterestOutput
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		interestRepoTx := u.interestRepository.WithTx(transactionObject)
+
+		wallet, err := u.walletRepository.WithTx(transactionObject).GetByID(contextWithTx, input.WalletID)
+		if err != nil {
+			if err == domain.ErrWalletNotFound {
+				return err
+			}
+			return fmt.Errorf("erro ao buscar carteira: %w", err)
+		}
+		// Só carteiras de clientes rendem; uma carteira encerrada não recebe mais nada
+		if wallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+		if err := wallet.CanCredit(); err != nil {
+			return err
+		}
+
+		if err := interestRepoTx.SaveAccount(contextWithTx, account); err != nil {
+			return fmt.Errorf("erro ao salvar rendimento da carteira: %w", err)
+		}
+		// A leitura de volta usa a mesma transação: fora dela, a gravação ainda não aparece
+		output, err = NewGetWalletInterest(interestRepoTx).Execute(contextWithTx, input.WalletID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+type UpdateWalletLimitsInput struct {
+	WalletID int64
+	// Tier vazio mantém a faixa padrão
+	Tier      string
+	Overrides domain.VelocityLimits
+}
+
+// UpdateWalletLimitsUseCase define a faixa e as sobrescritas de limites de uma carteira.
+// A configuração é substituída por inteiro: sobrescrita omitida volta a herdar da faixa.
+type UpdateWalletLimitsUseCase struct {
+	walletRepository   gateway.WalletRepository
+	limitRepository    gateway.LimitRepository
+	transactionManager gateway.TransactionManager
+}
+
+func NewUpdateWalletLimits(walletRepo gateway.WalletRepository, limitRepo gateway.LimitRepository, txManager gateway.TransactionManager) *UpdateWalletLimitsUseCase {
+	return &UpdateWalletLimitsUseCase{
+		walletRepository:   walletRepo,
+		limitRepository:    limitRepo,
+		transactionManager: txManager,
+	}
+}
+
+func (u *UpdateWalletLimitsUseCase) Execute(ctx context.Context, input UpdateWalletLimitsInput) (*GetWalletLimitsOutput, error) {
+	if err := input.Overrides.Validate(); err != nil {
+		return nil, err
+	}
+	if input.Tier == "" {
+		input.Tier = domain.DefaultLimitTier
+	}
+
+	var output *GetWalletLimitsOutput
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		walletRepoTx := u.walletRepository.WithTx(transactionObject)
+		limitRepoTx := u.limitRepository.WithTx(transactionObject)
+
+		wallet, err := walletRepoTx.GetByID(contextWithTx, input.WalletID)
+		if err != nil {
+			if err == domain.ErrWalletNotFound {
+				return err
+			}
+			return fmt.Errorf("erro ao buscar carteira: %w", err)
+		}
+		// Carteiras de sistema não fazem transferências de cliente
+		if wallet.IsSystem() {
+			return domain.ErrSystemWallet
+		}
+
+		limits := &domain.WalletLimits{
+			WalletID:  wallet.ID,
+			Tier:      input.Tier,
+			Overrides: input.Overrides,
+		}
+		if err := limitRepoTx.SaveWalletLimits(contextWithTx, limits); err != nil {
+			if err == domain.ErrLimitTierNotFound {
+				return err
+			}
+			return fmt.Errorf("erro ao salvar limites da carteira: %w", err)
+		}
+
+		// Devolve a configuração resultante com o uso atual, lida na mesma transação
+		output, err = NewGetWalletLimits(walletRepoTx, limitRepoTx).Execute(contextWithTx, wallet.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// effectiveLimits resolve os limites de uma carteira: os da faixa, com as sobrescritas da carteira por cima
+func effectiveLimits(ctx context.Context, limitRepo gateway.LimitRepository, walletID int64) (*domain.WalletLimits, domain.VelocityLimits, error) {
+	walletLimits, err := limitRepo.GetWalletLimits(ctx, walletID)
+	if err != nil {
+		return nil, domain.VelocityLimits{}, fmt.Errorf("falha ao buscar limites da carteira %d: %w", walletID, err)
+	}
+	tier, err := limitRepo.GetTier(ctx, walletLimits.Tier)
+	if err != nil {
+		return nil, domain.VelocityLimits{}, fmt.Errorf("falha ao buscar faixa de limites %s: %w", walletLimits.Tier, err)
+	}
+	return walletLimits, tier.Limits.Merge(walletLimits.Overrides), nil
+}
+
+// checkVelocityLimits valida uma saída de 'amount' contra os limites da carteira.
+// Deve rodar dentro do Uow com a carteira de origem travada: saídas concorrentes da mesma
+// carteira esperam o Commit, então a soma lida aqui não muda até o fim da transação.
+func checkVelocityLimits(ctx context.Context, limitRepo gateway.LimitRepository, walletID int64, amount int64, now time.Time) error {
+	_, limits, err := effectiveLimits(ctx, limitRepo, walletID)
+	if err != nil {
+		return err
+	}
+
+	windows := domain.LimitWindowsAt(now)
+	usage, err := limitRepo.OutgoingUsage(ctx, walletID, windows)
+	if err != nil {
+		return fmt.Errorf("falha ao somar saídas da carteira %d: %w", walletID, err)
+	}
+	return limits.Check(amount, usage, windows.Night)
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// VoidHoldUseCase cancela uma autorização ativa e devolve o saldo reservado.
+// Também é usado pela varredura de expiração, mudando apenas o status final.
+type VoidHoldUseCase struct {
+	walletRepository   gateway.WalletRepository
+	holdRepository     gateway.HoldRepository
+	transactionManager gateway.TransactionManager
+	eventPublisher     gateway.EventPublisher
+}
+
+func NewVoidHold(
+	walletRepo gateway.WalletRepository,
+	holdRepo gateway.HoldRepository,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *VoidHoldUseCase {
+	return &VoidHoldUseCase{
+		walletRepository:   walletRepo,
+		holdRepository:     holdRepo,
+		transactionManager: txManager,
+		eventPublisher:     publisher,
+	}
+}
+
+func (u *VoidHoldUseCase) Execute(ctx context.Context, holdID string) (*HoldOutput, error) {
+	hold, err := u.release(ctx, holdID, domain.HoldStatusVoided)
+	if err != nil {
+		return nil, err
+	}
+	return toHoldOutput(hold), nil
+}
+
+// release encerra a autorização com o status informado (voided ou expired) e libera a reserva
+func (u *VoidHoldUseCase) release(ctx context.Context, holdID string, status string) (*domain.Hold, error) {
+	var hold *domain.Hold
+
+	err := u.transactionManager.Run(ctx, func(contextWithTx context.Context) error {
+		transactionObject := contextWithTx.Value(gateway.TransactionKey)
+		if transactionObject == nil {
+			return fmt.Errorf("erro crítico: transação não encontrada no contexto")
+		}
+		holdRepoTx := u.holdRepository.WithTx(transactionObject)
+
+		var err error
+		hold, err = holdRepoTx.GetByIDForUpdate(contextWithTx, holdID)
+		if err != nil {
+			return err
+		}
+		if !hold.IsActive() {
+			return domain.ErrHoldNotActive
+		}
+
+		if err := u.walletRepository.WithTx(transactionObject).Release(contextWithTx, hold.WalletID, hold.Amount); err != nil {
+			return err
+		}
+
+		hold.Status = status
+		return holdRepoTx.Close(contextWithTx, hold)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishHoldEvent(ctx, u.eventPublisher, hold)
+	return hold, nil
+}
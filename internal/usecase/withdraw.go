@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/domain"
+	"github.com/Guilherme-G-Cadilhe/Go-LedgerFlow-Banking-API-Microservices/internal/gateway"
+)
+
+// WithdrawUseCase debita da carteira do cliente o dinheiro que sai da instituição (cash-out),
+// com a contrapartida na carteira de liquidação da moeda. Usa o saldo disponível (autorizações
+// ativas não podem ser sacadas; o cheque especial pode). Consome os limites de movimentação.
+type WithdrawUseCase struct {
+	movement cashMovement
+}
+
+func NewWithdraw(
+	walletRepo gateway.WalletRepository,
+	transactionRepo gateway.TransactionRepository,
+	entryRepo gateway.EntryRepository,
+	limitRepo gateway.LimitRepository,
+	txManager gateway.TransactionManager,
+	publisher gateway.EventPublisher,
+) *WithdrawUseCase {
+	return &WithdrawUseCase{
+		movement: cashMovement{
+			kind:                  domain.TransactionKindWithdrawal,
+			walletRepository:      walletRepo,
+			transactionRepository: transactionRepo,
+			entryRepository:       entryRepo,
+			limitRepository:       limitRepo,
+			transactionManager:    txManager,
+			eventPublisher:        publisher,
+		},
+	}
+}
+
+func (u *WithdrawUseCase) Execute(ctx context.Context, input CashMovementInput) (*CashMovementOutput, error) {
+	return u.movement.execute(ctx, input)
+}
@@ -16,6 +16,9 @@ show_help() {
     echo -e "  ${GREEN}test${NC}      -> Roda testes unitários"
     echo -e "  ${GREEN}test-int${NC}  -> Roda testes de integração"
     echo -e "  ${GREEN}run-api${NC}   -> Roda a API localmente"
+    echo -e "  ${GREEN}run-scheduler${NC} -> Roda o scheduler (agendamentos e expiração de holds)"
+    echo -e "  ${GREEN}run-interest${NC}  -> Roda o job de juros (rendimento diário e pagamento mensal)"
+    echo -e "  ${GREEN}run-snapshot${NC}  -> Roda o job de fotografias de saldo (saldo de fim de dia)"
 }
 
 case "$1" in
@@ -56,6 +59,18 @@ case "$1" in
         echo -e "${YELLOW}🔌 Iniciando API...${NC}"
         go run cmd/api/main.go
         ;;
+    run-scheduler)
+        echo -e "${YELLOW}⏰ Iniciando Scheduler...${NC}"
+        go run cmd/scheduler/main.go
+        ;;
+    run-interest)
+        echo -e "${YELLOW}💰 Iniciando job de juros...${NC}"
+        go run cmd/interest/main.go "${@:2}"
+        ;;
+    run-snapshot)
+        echo -e "${YELLOW}📸 Iniciando job de fotografias de saldo...${NC}"
+        go run cmd/snapshot/main.go "${@:2}"
+        ;;
     *)
         show_help
         ;;
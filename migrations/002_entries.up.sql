@@ -0,0 +1,57 @@
+-- migrations/002_entries.up.sql
+
+-- 3. Entries (Partidas dobradas)
+-- Toda transação gera lançamentos cuja soma é SEMPRE zero:
+-- débito (negativo) na carteira de origem e crédito (positivo) na de destino.
+-- wallets.balance passa a ser apenas um cache materializado: o saldo real
+-- de qualquer carteira pode ser reconstruído com SUM(entries.amount).
+CREATE TABLE IF NOT EXISTS entries (
+    id BIGSERIAL PRIMARY KEY,
+    -- NULL apenas para lançamentos de saldo de abertura (carteira criada com saldo inicial)
+    transaction_id UUID REFERENCES transactions(id),
+    wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    -- Em centavos. Negativo = débito, Positivo = crédito
+    amount BIGINT NOT NULL CHECK (amount <> 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_entries_wallet ON entries(wallet_id, id);
+CREATE INDEX idx_entries_transaction ON entries(transaction_id);
+
+-- Backfill: transações já existentes viram um par débito/crédito
+INSERT INTO entries (transaction_id, wallet_id, amount, created_at)
+SELECT id, from_wallet_id, -amount, created_at FROM transactions;
+
+INSERT INTO entries (transaction_id, wallet_id, amount, created_at)
+SELECT id, to_wallet_id, amount, created_at FROM transactions;
+
+-- Saldo de abertura: a parte do saldo atual que o histórico não explica
+-- (carteiras criadas com saldo inicial ou ajustadas manualmente).
+INSERT INTO entries (transaction_id, wallet_id, amount, created_at)
+SELECT NULL, w.id, w.balance - COALESCE(SUM(e.amount), 0), w.created_at
+FROM wallets w
+LEFT JOIN entries e ON e.wallet_id = w.id
+GROUP BY w.id, w.balance, w.created_at
+HAVING w.balance - COALESCE(SUM(e.amount), 0) <> 0;
+
+-- Garante no nível do banco que nenhuma transação fica desbalanceada.
+-- A trigger é DEFERRED: só é avaliada no COMMIT, depois que todas as partidas foram gravadas.
+CREATE OR REPLACE FUNCTION check_transaction_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.transaction_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    IF (SELECT SUM(amount) FROM entries WHERE transaction_id = NEW.transaction_id) <> 0 THEN
+        RAISE EXCEPTION 'transaction % is unbalanced', NEW.transaction_id
+            USING ERRCODE = 'check_violation';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER trg_entries_balanced
+AFTER INSERT ON entries
+DEFERRABLE INITIALLY DEFERRED
+FOR EACH ROW EXECUTE FUNCTION check_transaction_balanced();
@@ -0,0 +1,34 @@
+-- migrations/003_currencies.up.sql
+
+-- Moeda ISO 4217 em carteiras, transações e partidas.
+-- Os valores continuam em BIGINT, mas agora na menor unidade DA MOEDA
+-- (centavos para BRL/USD/EUR, unidade inteira para JPY, etc).
+ALTER TABLE wallets ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'BRL';
+ALTER TABLE transactions ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'BRL';
+ALTER TABLE entries ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'BRL';
+
+-- Uma partida só pode existir na moeda da própria carteira
+ALTER TABLE wallets ADD CONSTRAINT wallets_id_currency_key UNIQUE (id, currency);
+ALTER TABLE entries ADD CONSTRAINT entries_wallet_currency_fkey
+    FOREIGN KEY (wallet_id, currency) REFERENCES wallets(id, currency);
+
+-- O balanceamento agora é por moeda: débitos e créditos de cada moeda somam zero
+CREATE OR REPLACE FUNCTION check_transaction_balanced() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.transaction_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    IF EXISTS (
+        SELECT 1 FROM entries
+        WHERE transaction_id = NEW.transaction_id
+        GROUP BY currency
+        HAVING SUM(amount) <> 0
+    ) THEN
+        RAISE EXCEPTION 'transaction % is unbalanced', NEW.transaction_id
+            USING ERRCODE = 'check_violation';
+    END IF;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
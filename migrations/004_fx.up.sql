@@ -0,0 +1,44 @@
+-- migrations/004_fx.up.sql
+
+-- 4. Carteiras de Sistema
+-- Contas da própria instituição (não pertencem a clientes), identificadas por system_code + moeda.
+-- Elas podem ficar negativas: representam a posição da casa, não dinheiro de cliente.
+ALTER TABLE wallets ADD COLUMN system_code VARCHAR(30);
+CREATE UNIQUE INDEX idx_wallets_system_code ON wallets(system_code, currency) WHERE system_code IS NOT NULL;
+
+ALTER TABLE wallets DROP CONSTRAINT wallets_balance_check;
+ALTER TABLE wallets ADD CONSTRAINT wallets_balance_check CHECK (system_code IS NOT NULL OR balance >= 0);
+
+-- Posição de câmbio: uma transferência BRL -> USD vira 4 partidas
+-- (cliente BRL -> posição BRL, posição USD -> cliente USD), mantendo cada moeda balanceada.
+INSERT INTO wallets (balance, currency, system_code) VALUES
+    (0, 'BRL', 'fx_position'),
+    (0, 'USD', 'fx_position'),
+    (0, 'EUR', 'fx_position');
+
+-- 5. Cotações de câmbio
+-- O cliente trava a cotação por um TTL curto e executa a transferência contra o ID dela.
+CREATE TABLE IF NOT EXISTS fx_quotes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source_currency CHAR(3) NOT NULL,
+    destination_currency CHAR(3) NOT NULL,
+    -- Quantas unidades da moeda de destino valem 1 unidade da moeda de origem
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    source_amount BIGINT NOT NULL CHECK (source_amount > 0),
+    destination_amount BIGINT NOT NULL CHECK (destination_amount > 0),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    -- Uma cotação só pode ser usada uma vez
+    used_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT different_currencies CHECK (source_currency != destination_currency)
+);
+
+-- Dados do câmbio aplicado (NULL em transferências na mesma moeda)
+ALTER TABLE transactions ADD COLUMN destination_amount BIGINT;
+ALTER TABLE transactions ADD COLUMN destination_currency CHAR(3);
+ALTER TABLE transactions ADD COLUMN fx_rate NUMERIC(20, 10);
+ALTER TABLE transactions ADD COLUMN fx_quote_id UUID REFERENCES fx_quotes(id);
+
+-- Uma cotação gera no máximo uma transação
+CREATE UNIQUE INDEX idx_transactions_fx_quote ON transactions(fx_quote_id) WHERE fx_quote_id IS NOT NULL;
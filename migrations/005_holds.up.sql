@@ -0,0 +1,31 @@
+-- migrations/005_holds.up.sql
+
+-- 6. Autorizações (Holds)
+-- Pagamento em duas fases, estilo cartão: o valor é reservado na carteira e depois
+-- capturado (total ou parcialmente), cancelado ou expirado.
+-- Saldo disponível = balance - held_amount. O dinheiro só sai da carteira na captura.
+ALTER TABLE wallets ADD COLUMN held_amount BIGINT NOT NULL DEFAULT 0 CHECK (held_amount >= 0);
+
+CREATE TABLE IF NOT EXISTS holds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wallet_id BIGINT NOT NULL,
+    -- Carteira do lojista que recebe o valor na captura
+    to_wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    captured_amount BIGINT NOT NULL DEFAULT 0,
+    currency CHAR(3) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active', -- active, captured, voided, expired
+    -- Transferência gerada pela captura
+    transaction_id UUID REFERENCES transactions(id),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (wallet_id, currency) REFERENCES wallets(id, currency),
+    CONSTRAINT captured_within_amount CHECK (captured_amount >= 0 AND captured_amount <= amount),
+    CONSTRAINT different_wallets CHECK (wallet_id != to_wallet_id)
+);
+
+CREATE INDEX idx_holds_wallet ON holds(wallet_id, created_at);
+-- Varredura de expiração olha só para as autorizações ativas
+CREATE INDEX idx_holds_expiring ON holds(expires_at) WHERE status = 'active';
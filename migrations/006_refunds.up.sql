@@ -0,0 +1,12 @@
+-- migrations/006_refunds.up.sql
+
+-- 7. Estornos (Refunds)
+-- O estorno é uma nova transação, no sentido inverso, que aponta para a original.
+-- A original acumula o total estornado e muda de status (partially_refunded / refunded).
+ALTER TABLE transactions ADD COLUMN refunded_amount BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE transactions ADD COLUMN original_transaction_id UUID REFERENCES transactions(id);
+
+-- Vários estornos parciais são permitidos, mas nunca acima do valor original
+ALTER TABLE transactions ADD CONSTRAINT refunded_within_amount CHECK (refunded_amount >= 0 AND refunded_amount <= amount);
+
+CREATE INDEX idx_transactions_original ON transactions(original_transaction_id) WHERE original_transaction_id IS NOT NULL;
@@ -0,0 +1,28 @@
+-- migrations/007_scheduled_transfers.up.sql
+
+-- 8. Transferências Agendadas
+-- O pedido fica guardado até execute_at. O processo cmd/scheduler executa os vencidos
+-- via TransferMoneyUseCase, com uma Idempotency-Key derivada do ID do agendamento.
+CREATE TABLE IF NOT EXISTS scheduled_transfers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    to_wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    execute_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending', -- pending, processing, executed, failed, cancelled
+    attempts INT NOT NULL DEFAULT 0,
+    -- Transferência gerada na execução
+    transaction_id UUID REFERENCES transactions(id),
+    -- Motivo da última falha (a falha definitiva fica com status = failed)
+    failure_reason TEXT,
+    executed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT different_wallets CHECK (from_wallet_id != to_wallet_id)
+);
+
+CREATE INDEX idx_scheduled_transfers_wallet ON scheduled_transfers(from_wallet_id, execute_at);
+-- O scheduler só olha para os pendentes (e para os "processing" abandonados)
+CREATE INDEX idx_scheduled_transfers_due ON scheduled_transfers(execute_at) WHERE status IN ('pending', 'processing');
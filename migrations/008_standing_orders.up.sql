@@ -0,0 +1,48 @@
+-- migrations/008_standing_orders.up.sql
+
+-- 9. Ordens Permanentes (transferências recorrentes)
+-- A recorrência é guardada como RRULE (subconjunto da RFC 5545), ex: FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12.
+-- O cmd/scheduler executa cada ocorrência via TransferMoneyUseCase.
+CREATE TABLE IF NOT EXISTS standing_orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    from_wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    to_wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    rrule TEXT NOT NULL,
+    start_at TIMESTAMP WITH TIME ZONE NOT NULL,
+
+    -- Sem saldo: 'skip' pula a ocorrência, 'retry' tenta de novo a cada retry_interval_seconds
+    insufficient_funds_policy VARCHAR(10) NOT NULL DEFAULT 'skip' CHECK (insufficient_funds_policy IN ('skip', 'retry')),
+    max_retries INT NOT NULL DEFAULT 3 CHECK (max_retries >= 0),
+    retry_interval_seconds INT NOT NULL DEFAULT 3600 CHECK (retry_interval_seconds > 0),
+
+    status VARCHAR(20) NOT NULL DEFAULT 'active', -- active, paused, cancelled, completed
+    occurrences_count INT NOT NULL DEFAULT 0,
+    next_occurrence_at TIMESTAMP WITH TIME ZONE,
+    next_run_at TIMESTAMP WITH TIME ZONE,
+    attempts INT NOT NULL DEFAULT 0,
+    last_failure_reason TEXT,
+    -- Reserva do executor (várias instâncias do scheduler não processam a mesma ordem)
+    claimed_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT different_wallets CHECK (from_wallet_id != to_wallet_id)
+);
+
+CREATE INDEX idx_standing_orders_wallet ON standing_orders(from_wallet_id, created_at);
+CREATE INDEX idx_standing_orders_due ON standing_orders(next_run_at) WHERE status = 'active';
+
+-- Histórico das ocorrências (uma linha por data da série)
+CREATE TABLE IF NOT EXISTS standing_order_occurrences (
+    id BIGSERIAL PRIMARY KEY,
+    standing_order_id UUID NOT NULL REFERENCES standing_orders(id),
+    occurrence_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status VARCHAR(20) NOT NULL, -- executed, skipped, failed
+    transaction_id UUID REFERENCES transactions(id),
+    failure_reason TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+
+    UNIQUE (standing_order_id, occurrence_at)
+);
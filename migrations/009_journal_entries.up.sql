@@ -0,0 +1,16 @@
+-- migrations/009_journal_entries.up.sql
+
+-- 10. Lançamentos com N pernas (journal entries)
+-- Uma transação 'journal' agrupa várias partidas balanceadas (ex: comprador -> lojista,
+-- comprador -> taxa da plataforma, comprador -> imposto) sob um único ID.
+-- Ela não tem um par origem/destino: as pernas ficam apenas em entries.
+ALTER TABLE transactions ADD COLUMN kind VARCHAR(20) NOT NULL DEFAULT 'transfer';
+ALTER TABLE transactions ADD COLUMN description TEXT;
+
+ALTER TABLE transactions ALTER COLUMN from_wallet_id DROP NOT NULL;
+ALTER TABLE transactions ALTER COLUMN to_wallet_id DROP NOT NULL;
+
+ALTER TABLE transactions ADD CONSTRAINT transactions_kind_check CHECK (kind IN ('transfer', 'journal'));
+-- Transferências continuam exigindo origem e destino
+ALTER TABLE transactions ADD CONSTRAINT transfer_has_wallets
+    CHECK (kind <> 'transfer' OR (from_wallet_id IS NOT NULL AND to_wallet_id IS NOT NULL));
@@ -0,0 +1,21 @@
+-- migrations/010_overdraft_limit.up.sql
+
+-- 11. Limite de cheque especial (linha de crédito) por carteira
+-- O saldo de clientes pode ficar negativo até -overdraft_limit. Padrão 0 = comportamento anterior.
+ALTER TABLE wallets ADD COLUMN overdraft_limit BIGINT NOT NULL DEFAULT 0 CHECK (overdraft_limit >= 0);
+
+ALTER TABLE wallets DROP CONSTRAINT wallets_balance_check;
+ALTER TABLE wallets ADD CONSTRAINT wallets_balance_check CHECK (system_code IS NOT NULL OR balance >= -overdraft_limit);
+
+-- Trilha de auditoria das alterações de limite (feitas pela rota administrativa)
+CREATE TABLE IF NOT EXISTS wallet_limit_changes (
+    id BIGSERIAL PRIMARY KEY,
+    wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    previous_limit BIGINT NOT NULL,
+    new_limit BIGINT NOT NULL,
+    changed_by VARCHAR(100) NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_wallet_limit_changes_wallet ON wallet_limit_changes(wallet_id, id);
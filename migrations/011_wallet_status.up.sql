@@ -0,0 +1,11 @@
+-- migrations/011_wallet_status.up.sql
+
+-- 12. Ciclo de vida da carteira
+-- active: movimenta normalmente | frozen: bloqueada para débitos (conta comprometida, ordem judicial)
+-- closed: encerrada, não envia nem recebe. Estado final.
+ALTER TABLE wallets ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'frozen', 'closed'));
+
+-- Carteira encerrada não pode guardar dinheiro: o encerramento exige saldo zerado e sem reservas
+ALTER TABLE wallets ADD CONSTRAINT wallets_closed_empty_check
+    CHECK (status <> 'closed' OR (balance = 0 AND held_amount = 0));
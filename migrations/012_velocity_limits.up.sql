@@ -0,0 +1,38 @@
+-- migrations/012_velocity_limits.up.sql
+
+-- 13. Limites de movimentação (velocity limits)
+-- Valores na menor unidade da moeda da carteira. NULL = sem limite.
+-- Os limites noturnos valem das 20:00 às 06:00 (horário de Brasília), como exige o Pix.
+CREATE TABLE IF NOT EXISTS limit_tiers (
+    code VARCHAR(30) PRIMARY KEY,
+    max_single_amount BIGINT CHECK (max_single_amount >= 0),
+    daily_amount BIGINT CHECK (daily_amount >= 0),
+    daily_count BIGINT CHECK (daily_count >= 0),
+    monthly_amount BIGINT CHECK (monthly_amount >= 0),
+    monthly_count BIGINT CHECK (monthly_count >= 0),
+    night_single_amount BIGINT CHECK (night_single_amount >= 0),
+    night_amount BIGINT CHECK (night_amount >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO limit_tiers (code, max_single_amount, daily_amount, daily_count, monthly_amount, monthly_count, night_single_amount, night_amount) VALUES
+    ('standard', 500000, 1000000, 50, 10000000, 500, 100000, 100000),
+    ('business', 10000000, 50000000, 1000, 500000000, 20000, 1000000, 5000000);
+
+-- Configuração por carteira: a faixa e os limites que a sobrescrevem.
+-- Aqui NULL significa "herda da faixa". Carteiras sem linha usam a faixa standard.
+CREATE TABLE IF NOT EXISTS wallet_limits (
+    wallet_id BIGINT PRIMARY KEY REFERENCES wallets(id),
+    tier_code VARCHAR(30) NOT NULL DEFAULT 'standard' REFERENCES limit_tiers(code),
+    max_single_amount BIGINT CHECK (max_single_amount >= 0),
+    daily_amount BIGINT CHECK (daily_amount >= 0),
+    daily_count BIGINT CHECK (daily_count >= 0),
+    monthly_amount BIGINT CHECK (monthly_amount >= 0),
+    monthly_count BIGINT CHECK (monthly_count >= 0),
+    night_single_amount BIGINT CHECK (night_single_amount >= 0),
+    night_amount BIGINT CHECK (night_amount >= 0),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Soma das saídas por janela (dia, mês, noite) sem varrer todo o histórico da carteira
+CREATE INDEX idx_transactions_outgoing ON transactions(from_wallet_id, created_at) WHERE kind = 'transfer';
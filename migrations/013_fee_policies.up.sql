@@ -0,0 +1,33 @@
+-- migrations/013_fee_policies.up.sql
+
+-- 14. Tarifas sobre transferências
+-- A tarifa é debitada do remetente junto com o valor (uma única partida) e creditada
+-- na carteira de receita, na mesma transação do banco.
+ALTER TABLE transactions ADD COLUMN fee_amount BIGINT NOT NULL DEFAULT 0 CHECK (fee_amount >= 0);
+
+-- Carteiras de receita de tarifas (padrão quando a política não indica outra)
+INSERT INTO wallets (balance, currency, system_code) VALUES
+    (0, 'BRL', 'fee_revenue'),
+    (0, 'USD', 'fee_revenue'),
+    (0, 'EUR', 'fee_revenue');
+
+-- Política de tarifa por faixa (a mesma dos limites de movimentação) e moeda.
+-- flat: valor fixo | percentage: basis points (1 bps = 0,01%) com mínimo/máximo opcionais
+-- tiered: faixas por valor da transferência, [{"up_to": 10000, "fee": 100}, {"up_to": null, "fee": 500}]
+-- Sem política para a faixa/moeda, a transferência não tem tarifa.
+CREATE TABLE IF NOT EXISTS fee_policies (
+    id BIGSERIAL PRIMARY KEY,
+    tier_code VARCHAR(30) NOT NULL REFERENCES limit_tiers(code),
+    currency CHAR(3) NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('flat', 'percentage', 'tiered')),
+    flat_amount BIGINT NOT NULL DEFAULT 0 CHECK (flat_amount >= 0),
+    percentage_bps BIGINT NOT NULL DEFAULT 0 CHECK (percentage_bps >= 0 AND percentage_bps <= 10000),
+    min_amount BIGINT CHECK (min_amount >= 0),
+    max_amount BIGINT CHECK (max_amount >= 0),
+    brackets JSONB NOT NULL DEFAULT '[]',
+    -- NULL = carteira de sistema fee_revenue da moeda
+    revenue_wallet_id BIGINT REFERENCES wallets(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (tier_code, currency)
+);
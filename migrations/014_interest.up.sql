@@ -0,0 +1,57 @@
+-- migrations/014_interest.up.sql
+
+-- 15. Rendimento de carteiras de poupança
+-- Os juros rendem todo dia sobre o saldo de fim de dia (horário de Brasília) e são pagos
+-- uma vez por mês, numa transferência da tesouraria para a carteira.
+
+-- Tesouraria que paga os juros (uma por moeda)
+INSERT INTO wallets (balance, currency, system_code) VALUES
+    (0, 'BRL', 'interest_treasury'),
+    (0, 'USD', 'interest_treasury'),
+    (0, 'EUR', 'interest_treasury');
+
+-- Carteiras que rendem juros. annual_rate 0.10750000 = 10,75% a.a.
+-- carry_units é a fração de centavo que sobrou do último pagamento (veja interest_accruals)
+CREATE TABLE IF NOT EXISTS interest_accounts (
+    wallet_id BIGINT PRIMARY KEY REFERENCES wallets(id),
+    annual_rate NUMERIC(10,8) NOT NULL CHECK (annual_rate >= 0 AND annual_rate <= 1),
+    day_count VARCHAR(10) NOT NULL DEFAULT 'ACT/365' CHECK (day_count IN ('ACT/365', 'ACT/360', 'ACT/ACT')),
+    start_date DATE NOT NULL,
+    carry_units NUMERIC(40,0) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Pagamentos mensais: um por carteira e mês, o que torna o job seguro para reexecução.
+-- transaction_id é NULL quando o valor do mês não chegou a um centavo (a fração vai para carry_units).
+CREATE TABLE IF NOT EXISTS interest_payouts (
+    id BIGSERIAL PRIMARY KEY,
+    wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    period_start DATE NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount >= 0),
+    transaction_id UUID REFERENCES transactions(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (wallet_id, period_start)
+);
+
+-- Rendimento diário, sem arredondamento: amount_units está em 1/160308000000000 da menor unidade
+-- da moeda (10^8 casas da taxa × mmc(360, 365, 366) dias), então saldo × taxa ÷ dias do ano é exato.
+-- UNIQUE (wallet_id, accrual_date): reexecutar o job para a mesma data não rende duas vezes.
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    id BIGSERIAL PRIMARY KEY,
+    wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    accrual_date DATE NOT NULL,
+    balance BIGINT NOT NULL,
+    annual_rate NUMERIC(10,8) NOT NULL,
+    day_count VARCHAR(10) NOT NULL,
+    amount_units NUMERIC(40,0) NOT NULL CHECK (amount_units >= 0),
+    -- Preenchido quando o rendimento entra num pagamento
+    payout_id BIGINT REFERENCES interest_payouts(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (wallet_id, accrual_date)
+);
+
+CREATE INDEX idx_interest_accruals_unpaid ON interest_accruals(wallet_id, accrual_date) WHERE payout_id IS NULL;
+
+-- Saldo de fim de dia: soma das partidas até um instante
+CREATE INDEX idx_entries_wallet_created ON entries(wallet_id, created_at);
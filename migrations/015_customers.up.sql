@@ -0,0 +1,28 @@
+-- migrations/015_customers.up.sql
+
+-- 16. Clientes (titulares das carteiras)
+-- Um cliente pode ter várias carteiras (uma por moeda, poupança etc.).
+-- document guarda só os dígitos: 11 para CPF, 14 para CNPJ (os dígitos verificadores são conferidos na aplicação).
+CREATE TABLE IF NOT EXISTS customers (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(200) NOT NULL,
+    document_type VARCHAR(4) NOT NULL CHECK (document_type IN ('cpf', 'cnpj')),
+    document VARCHAR(14) NOT NULL UNIQUE,
+    email VARCHAR(254) NOT NULL,
+    phone VARCHAR(20),
+    kyc_status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (kyc_status IN ('pending', 'verified', 'rejected')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CONSTRAINT document_length CHECK (
+        (document_type = 'cpf' AND length(document) = 11) OR
+        (document_type = 'cnpj' AND length(document) = 14)
+    )
+);
+
+-- Titular da carteira. Carteiras de sistema não têm titular; as carteiras criadas antes desta
+-- migração também ficam sem, por isso a coluna aceita NULL (a API exige o cliente nas novas).
+-- Sem ON DELETE: um cliente com carteiras não pode ser removido.
+ALTER TABLE wallets ADD COLUMN customer_id BIGINT REFERENCES customers(id);
+
+CREATE INDEX idx_wallets_customer ON wallets(customer_id) WHERE customer_id IS NOT NULL;
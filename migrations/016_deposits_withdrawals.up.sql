@@ -0,0 +1,44 @@
+-- migrations/016_deposits_withdrawals.up.sql
+
+-- 17. Depósitos e saques
+-- Carteiras nascem zeradas: dinheiro só entra (depósito) ou sai (saque) do ledger por uma transação
+-- contra a carteira de liquidação da moeda. Assim a soma de todas as partidas de uma moeda é sempre zero.
+
+-- Liquidação: contrapartida do dinheiro que entra e sai da instituição (uma por moeda)
+INSERT INTO wallets (balance, currency, system_code) VALUES
+    (0, 'BRL', 'settlement'),
+    (0, 'USD', 'settlement'),
+    (0, 'EUR', 'settlement');
+
+ALTER TABLE transactions DROP CONSTRAINT transactions_kind_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_kind_check
+    CHECK (kind IN ('transfer', 'journal', 'deposit', 'withdrawal'));
+
+-- Depósito: liquidação -> carteira | Saque: carteira -> liquidação
+ALTER TABLE transactions DROP CONSTRAINT transfer_has_wallets;
+ALTER TABLE transactions ADD CONSTRAINT transfer_has_wallets
+    CHECK (kind = 'journal' OR (from_wallet_id IS NOT NULL AND to_wallet_id IS NOT NULL));
+
+-- Backfill: os saldos de abertura (carteiras criadas com saldo inicial) ganham a contrapartida
+-- na liquidação, para que o histórico também feche em zero por moeda
+INSERT INTO entries (transaction_id, wallet_id, amount, currency)
+SELECT NULL, s.id, -o.total, o.currency
+FROM (
+    SELECT e.currency, SUM(e.amount) AS total
+    FROM entries e
+    WHERE e.transaction_id IS NULL
+    GROUP BY e.currency
+    HAVING SUM(e.amount) <> 0
+) o
+JOIN wallets s ON s.system_code = 'settlement' AND s.currency = o.currency;
+
+UPDATE wallets s
+SET balance = e.amount
+FROM entries e
+WHERE e.wallet_id = s.id
+  AND e.transaction_id IS NULL
+  AND s.system_code = 'settlement';
+
+-- Daqui em diante toda partida pertence a uma transação (NOT VALID: o histórico de abertura fica como está)
+ALTER TABLE entries ADD CONSTRAINT entries_transaction_required
+    CHECK (transaction_id IS NOT NULL) NOT VALID;
@@ -0,0 +1,17 @@
+-- migrations/017_balance_snapshots.up.sql
+
+-- 18. Fotografias de saldo (saldo em data passada)
+-- O saldo num instante é a soma das partidas até ele. Para não somar anos de partidas a cada consulta,
+-- um job diário grava o saldo de fim de dia (horário de Brasília) das carteiras que tiveram movimento:
+-- a consulta parte da última fotografia antes do instante e soma só as partidas posteriores a ela.
+-- balance cobre as partidas com created_at < closes_at (meia-noite que encerra snapshot_date).
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    snapshot_date DATE NOT NULL,
+    closes_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    balance BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (wallet_id, snapshot_date)
+);
+
+CREATE INDEX idx_balance_snapshots_wallet_closes ON balance_snapshots(wallet_id, closes_at);
@@ -0,0 +1,26 @@
+-- migrations/018_idempotency_keys.up.sql
+
+-- 19. Idempotência durável
+-- A resposta de uma requisição com Idempotency-Key é gravada na mesma transação do banco que a operação:
+-- se a transferência foi efetivada, a resposta também foi, e a retentativa recebe a resposta original
+-- mesmo com o Redis fora do ar (o Redis passa a ser só um cache de leitura na frente desta tabela).
+-- status 'processing' é a reserva de quem está executando: expira em locked_until se a requisição cair.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key VARCHAR(255) PRIMARY KEY,
+    -- SHA-256 (hex) de método, rota e corpo canônico da requisição
+    fingerprint VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('processing', 'completed')),
+    lock_token VARCHAR(64),
+    locked_until TIMESTAMP WITH TIME ZONE,
+    -- Resposta gravada (só em 'completed')
+    status_code INT,
+    body BYTEA,
+    headers JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CONSTRAINT processing_has_lock CHECK (status <> 'processing' OR (lock_token IS NOT NULL AND locked_until IS NOT NULL)),
+    CONSTRAINT completed_has_response CHECK (status <> 'completed' OR status_code IS NOT NULL)
+);
+
+CREATE INDEX idx_idempotency_keys_expires ON idempotency_keys(expires_at);
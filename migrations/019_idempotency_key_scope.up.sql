@@ -0,0 +1,14 @@
+-- migrations/019_idempotency_key_scope.up.sql
+
+-- 20. Escopo das chaves de idempotência
+-- A mesma Idempotency-Key enviada por clientes diferentes (ou para rotas diferentes) é outra operação.
+-- client_id identifica o cliente autenticado; route é o método e o padrão da rota (ex: "POST /transfers").
+-- Chaves gravadas antes do escopo ficam com client_id e route vazios e expiram normalmente.
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS client_id VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS route VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (client_id, route, key);
+
+-- Consulta administrativa pela chave, em qualquer cliente ou rota
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_key ON idempotency_keys(key);
@@ -0,0 +1,10 @@
+-- migrations/020_transaction_idempotency_scope.up.sql
+
+-- 21. Escopo da Idempotency-Key nas transações
+-- Como em idempotency_keys (019), a chave vale dentro do cliente e da rota que a enviou:
+-- idempotency_scope é "<client_id> <rota>" (ex: "credential:ab12... POST /transfers").
+-- Transações gravadas antes do escopo ficam com idempotency_scope vazio.
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS idempotency_scope VARCHAR(400) NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS idx_transactions_idem_key;
+CREATE UNIQUE INDEX idx_transactions_idem_key ON transactions(idempotency_scope, idempotency_key) WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,34 @@
+-- migrations/021_internal_idempotency_scope.up.sql
+
+-- 22. Escopo interno das chaves dos jobs
+-- Agendamentos, ordens permanentes e juros gravam a Idempotency-Key no escopo 'internal', que nenhum
+-- cliente alcança (o escopo de um cliente é sempre "<client_id> <rota>").
+-- Só entram no escopo as transações antigas que são mesmo do job: chave e pernas conferem.
+-- Uma transação de cliente que usou uma chave reservada fica no escopo vazio e não é mais encontrada pelo job.
+UPDATE transactions t
+SET idempotency_scope = 'internal'
+FROM scheduled_transfers s
+WHERE t.idempotency_scope = ''
+  AND t.idempotency_key = 'scheduled-transfer:' || s.id
+  AND t.from_wallet_id = s.from_wallet_id
+  AND t.to_wallet_id = s.to_wallet_id
+  AND t.amount = s.amount
+  AND t.currency = s.currency;
+
+UPDATE transactions t
+SET idempotency_scope = 'internal'
+FROM standing_orders o
+WHERE t.idempotency_scope = ''
+  AND t.idempotency_key LIKE 'standing-order:' || o.id || ':%'
+  AND t.from_wallet_id = o.from_wallet_id
+  AND t.to_wallet_id = o.to_wallet_id
+  AND t.amount = o.amount
+  AND t.currency = o.currency;
+
+-- Os juros são gravados na mesma transação do pagamento: interest_payouts aponta para eles
+UPDATE transactions t
+SET idempotency_scope = 'internal'
+FROM interest_payouts p
+WHERE t.idempotency_scope = ''
+  AND t.id = p.transaction_id
+  AND t.idempotency_key LIKE 'interest:%';
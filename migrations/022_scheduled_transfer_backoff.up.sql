@@ -0,0 +1,7 @@
+-- migrations/022_scheduled_transfer_backoff.up.sql
+
+-- 23. Espera entre as tentativas de um agendamento
+-- Depois de um erro transitório o agendamento volta para pending com next_attempt_at no futuro
+-- (backoff exponencial por tentativa): o scheduler só o reserva de novo a partir dali.
+-- NULL = sem espera (primeira execução).
+ALTER TABLE scheduled_transfers ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,32 @@
+-- name: CreateBalanceSnapshots :execrows
+-- Fotografa o saldo de fim de dia das carteiras com partidas desde a última fotografia:
+-- saldo da fotografia anterior + partidas entre ela e closes_at. Carteiras sem movimento
+-- não ganham linha (a fotografia anterior continua valendo). Reexecutar para a mesma data não duplica.
+INSERT INTO balance_snapshots (wallet_id, snapshot_date, closes_at, balance)
+SELECT w.id, sqlc.arg(snapshot_date)::date, sqlc.arg(closes_at)::timestamptz, COALESCE(last.balance, 0) + moved.amount
+FROM wallets w
+LEFT JOIN LATERAL (
+    SELECT s.balance, s.closes_at
+    FROM balance_snapshots s
+    WHERE s.wallet_id = w.id
+      AND s.closes_at < sqlc.arg(closes_at)
+    ORDER BY s.closes_at DESC
+    LIMIT 1
+) last ON TRUE
+CROSS JOIN LATERAL (
+    SELECT COALESCE(SUM(e.amount), 0)::bigint AS amount, COUNT(*) AS entries
+    FROM entries e
+    WHERE e.wallet_id = w.id
+      AND e.created_at >= COALESCE(last.closes_at, '-infinity')
+      AND e.created_at < sqlc.arg(closes_at)
+) moved
+WHERE moved.entries > 0
+ON CONFLICT (wallet_id, snapshot_date) DO NOTHING;
+
+-- name: GetLatestBalanceSnapshot :one
+-- Última fotografia fechada até o instante
+SELECT * FROM balance_snapshots
+WHERE wallet_id = sqlc.arg(wallet_id)
+  AND closes_at <= sqlc.arg(at)
+ORDER BY closes_at DESC
+LIMIT 1;
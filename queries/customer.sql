@@ -0,0 +1,30 @@
+-- name: CreateCustomer :one
+INSERT INTO customers (name, document_type, document, email, phone)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetCustomer :one
+SELECT * FROM customers
+WHERE id = $1;
+
+-- name: ListCustomers :many
+-- Paginação por cursor (keyset) sobre o id
+SELECT * FROM customers
+WHERE id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: UpdateCustomer :one
+-- O documento não muda: identifica o titular
+UPDATE customers
+SET name = $2,
+    email = $3,
+    phone = $4,
+    kyc_status = $5,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteCustomer :execrows
+DELETE FROM customers
+WHERE id = $1;
@@ -0,0 +1,46 @@
+-- name: CreateEntry :one
+INSERT INTO entries (
+    transaction_id,
+    wallet_id,
+    amount,
+    currency
+)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListEntriesByTransaction :many
+SELECT * FROM entries
+WHERE transaction_id = $1
+ORDER BY id;
+
+-- name: GetWalletLedgerBalance :one
+-- Saldo reconstruído a partir das partidas (fonte da verdade do ledger)
+SELECT COALESCE(SUM(amount), 0)::bigint AS balance
+FROM entries
+WHERE wallet_id = $1;
+
+-- name: GetWalletBalanceAt :one
+-- Saldo da carteira num instante: soma das partidas registradas antes dele
+SELECT COALESCE(SUM(amount), 0)::bigint AS balance
+FROM entries
+WHERE wallet_id = sqlc.arg(wallet_id)
+  AND created_at < sqlc.arg(at);
+
+-- name: SumWalletEntriesBetween :one
+-- Movimento líquido da carteira no intervalo [since, until)
+SELECT COALESCE(SUM(amount), 0)::bigint AS amount
+FROM entries
+WHERE wallet_id = sqlc.arg(wallet_id)
+  AND created_at >= sqlc.arg(since)
+  AND created_at < sqlc.arg(until);
+
+-- name: ListWalletDailyNetAmounts :many
+-- Movimento líquido por dia do calendário no fuso informado (só os dias com partidas)
+SELECT (created_at AT TIME ZONE sqlc.arg(time_zone)::text)::date AS day,
+       SUM(amount)::bigint AS amount
+FROM entries
+WHERE wallet_id = sqlc.arg(wallet_id)
+  AND created_at >= sqlc.arg(since)
+  AND created_at < sqlc.arg(until)
+GROUP BY day
+ORDER BY day;
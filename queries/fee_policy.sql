@@ -0,0 +1,37 @@
+-- name: GetFeePolicy :one
+SELECT * FROM fee_policies
+WHERE tier_code = $1
+  AND currency = $2;
+
+-- name: ListFeePolicies :many
+SELECT * FROM fee_policies
+ORDER BY tier_code, currency;
+
+-- name: UpsertFeePolicy :one
+INSERT INTO fee_policies (
+    tier_code,
+    currency,
+    kind,
+    flat_amount,
+    percentage_bps,
+    min_amount,
+    max_amount,
+    brackets,
+    revenue_wallet_id
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (tier_code, currency) DO UPDATE SET
+    kind = EXCLUDED.kind,
+    flat_amount = EXCLUDED.flat_amount,
+    percentage_bps = EXCLUDED.percentage_bps,
+    min_amount = EXCLUDED.min_amount,
+    max_amount = EXCLUDED.max_amount,
+    brackets = EXCLUDED.brackets,
+    revenue_wallet_id = EXCLUDED.revenue_wallet_id,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: DeleteFeePolicy :execrows
+DELETE FROM fee_policies
+WHERE tier_code = $1
+  AND currency = $2;
@@ -0,0 +1,22 @@
+-- name: CreateFXQuote :one
+INSERT INTO fx_quotes (
+    source_currency,
+    destination_currency,
+    rate,
+    source_amount,
+    destination_amount,
+    expires_at
+)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetFXQuoteForUpdate :one
+SELECT * FROM fx_quotes
+WHERE id = $1
+FOR UPDATE;
+
+-- name: MarkFXQuoteUsed :execrows
+UPDATE fx_quotes
+SET used_at = NOW()
+WHERE id = $1
+  AND used_at IS NULL;
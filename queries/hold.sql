@@ -0,0 +1,30 @@
+-- name: CreateHold :one
+INSERT INTO holds (wallet_id, to_wallet_id, amount, currency, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetHold :one
+SELECT * FROM holds
+WHERE id = $1;
+
+-- name: GetHoldForUpdate :one
+SELECT * FROM holds
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CloseHold :execrows
+-- Encerra a autorização (captured, voided ou expired). Só fecha se ainda estiver ativa.
+UPDATE holds
+SET status = sqlc.arg(status),
+    captured_amount = sqlc.arg(captured_amount),
+    transaction_id = sqlc.narg(transaction_id),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = 'active';
+
+-- name: ListExpiredHolds :many
+SELECT id FROM holds
+WHERE status = 'active'
+  AND expires_at <= $1
+ORDER BY expires_at
+LIMIT $2;
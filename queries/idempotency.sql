@@ -0,0 +1,92 @@
+-- name: GetIdempotencyResponse :one
+SELECT * FROM idempotency_keys
+WHERE client_id = $1
+  AND route = $2
+  AND key = $3
+  AND status = 'completed'
+  AND expires_at > NOW();
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE client_id = $1
+  AND route = $2
+  AND key = $3;
+
+-- name: ReserveIdempotencyKey :one
+-- Reserva a chave (SET NX): só toma a linha existente se a reserva anterior venceu (requisição caiu)
+-- ou se a resposta gravada expirou. Nenhuma linha = a chave está com outra requisição.
+INSERT INTO idempotency_keys (client_id, route, key, fingerprint, status, lock_token, locked_until, expires_at)
+VALUES (
+    sqlc.arg(client_id),
+    sqlc.arg(route),
+    sqlc.arg(key),
+    sqlc.arg(fingerprint),
+    'processing',
+    sqlc.arg(lock_token),
+    NOW() + sqlc.arg(lease)::interval,
+    NOW() + sqlc.arg(lease)::interval
+)
+ON CONFLICT (client_id, route, key) DO UPDATE SET
+    fingerprint = EXCLUDED.fingerprint,
+    status = 'processing',
+    lock_token = EXCLUDED.lock_token,
+    locked_until = EXCLUDED.locked_until,
+    status_code = NULL,
+    body = NULL,
+    headers = NULL,
+    created_at = NOW(),
+    completed_at = NULL,
+    expires_at = EXCLUDED.expires_at
+WHERE (idempotency_keys.status = 'processing' AND idempotency_keys.locked_until < NOW())
+   OR idempotency_keys.expires_at < NOW()
+RETURNING key;
+
+-- name: SaveIdempotencyResponse :exec
+INSERT INTO idempotency_keys (client_id, route, key, fingerprint, status, status_code, body, headers, completed_at, expires_at)
+VALUES (
+    sqlc.arg(client_id),
+    sqlc.arg(route),
+    sqlc.arg(key),
+    sqlc.arg(fingerprint),
+    'completed',
+    sqlc.arg(status_code),
+    sqlc.arg(body),
+    sqlc.arg(headers),
+    NOW(),
+    NOW() + sqlc.arg(ttl)::interval
+)
+ON CONFLICT (client_id, route, key) DO UPDATE SET
+    fingerprint = EXCLUDED.fingerprint,
+    status = 'completed',
+    lock_token = NULL,
+    locked_until = NULL,
+    status_code = EXCLUDED.status_code,
+    body = EXCLUDED.body,
+    headers = EXCLUDED.headers,
+    completed_at = EXCLUDED.completed_at,
+    expires_at = EXCLUDED.expires_at;
+
+-- name: ReleaseIdempotencyKey :exec
+-- Só apaga a reserva se ainda for nossa e não virou resposta gravada
+DELETE FROM idempotency_keys
+WHERE client_id = $1
+  AND route = $2
+  AND key = $3
+  AND status = 'processing'
+  AND lock_token = $4;
+
+-- name: ListIdempotencyKeys :many
+-- Consulta administrativa: todas as gravações de uma chave, opcionalmente de um cliente ou rota
+SELECT * FROM idempotency_keys
+WHERE key = sqlc.arg(key)
+  AND (sqlc.narg(client_id)::text IS NULL OR client_id = sqlc.narg(client_id))
+  AND (sqlc.narg(route)::text IS NULL OR route = sqlc.narg(route))
+ORDER BY created_at DESC;
+
+-- name: DeleteIdempotencyKeys :many
+-- Expurgo administrativo: o próximo envio da chave executa a operação de novo
+DELETE FROM idempotency_keys
+WHERE key = sqlc.arg(key)
+  AND (sqlc.narg(client_id)::text IS NULL OR client_id = sqlc.narg(client_id))
+  AND (sqlc.narg(route)::text IS NULL OR route = sqlc.narg(route))
+RETURNING client_id, route, key;
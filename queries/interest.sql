@@ -0,0 +1,71 @@
+-- name: UpsertInterestAccount :one
+-- Reconfigurar a taxa não muda start_date nem a fração acumulada
+INSERT INTO interest_accounts (wallet_id, annual_rate, day_count, start_date)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (wallet_id) DO UPDATE SET
+    annual_rate = EXCLUDED.annual_rate,
+    day_count = EXCLUDED.day_count,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetInterestAccount :one
+SELECT * FROM interest_accounts
+WHERE wallet_id = $1;
+
+-- name: GetInterestAccountForUpdate :one
+SELECT * FROM interest_accounts
+WHERE wallet_id = $1
+FOR UPDATE;
+
+-- name: ListInterestAccountsForAccrual :many
+SELECT * FROM interest_accounts
+WHERE start_date <= sqlc.arg(accrual_date)
+ORDER BY wallet_id;
+
+-- name: UpdateInterestAccountCarry :exec
+UPDATE interest_accounts
+SET carry_units = $2,
+    updated_at = NOW()
+WHERE wallet_id = $1;
+
+-- name: CreateInterestAccrual :execrows
+-- 0 linhas = a data já rendeu para a carteira (reexecução do job)
+INSERT INTO interest_accruals (wallet_id, accrual_date, balance, annual_rate, day_count, amount_units)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (wallet_id, accrual_date) DO NOTHING;
+
+-- name: ListWalletsWithUnpaidInterest :many
+SELECT DISTINCT wallet_id FROM interest_accruals
+WHERE payout_id IS NULL
+  AND accrual_date <= sqlc.arg(until)
+ORDER BY wallet_id;
+
+-- name: SumUnpaidInterestAccruals :one
+SELECT COALESCE(SUM(amount_units), 0)::numeric AS amount_units
+FROM interest_accruals
+WHERE wallet_id = sqlc.arg(wallet_id)
+  AND payout_id IS NULL
+  AND accrual_date <= sqlc.arg(until);
+
+-- name: MarkInterestAccrualsPaid :exec
+UPDATE interest_accruals
+SET payout_id = sqlc.arg(payout_id)
+WHERE wallet_id = sqlc.arg(wallet_id)
+  AND payout_id IS NULL
+  AND accrual_date <= sqlc.arg(until);
+
+-- name: GetInterestPayout :one
+SELECT * FROM interest_payouts
+WHERE wallet_id = $1
+  AND period_start = $2;
+
+-- name: CreateInterestPayout :one
+INSERT INTO interest_payouts (wallet_id, period_start, amount, transaction_id)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: ListInterestPayouts :many
+SELECT * FROM interest_payouts
+WHERE wallet_id = sqlc.arg(wallet_id)
+ORDER BY period_start DESC
+LIMIT sqlc.arg(page_size);
@@ -0,0 +1,76 @@
+-- name: GetLimitTier :one
+SELECT * FROM limit_tiers
+WHERE code = $1;
+
+-- name: ListLimitTiers :many
+SELECT * FROM limit_tiers
+ORDER BY code;
+
+-- name: UpsertLimitTier :one
+INSERT INTO limit_tiers (
+    code,
+    max_single_amount,
+    daily_amount,
+    daily_count,
+    monthly_amount,
+    monthly_count,
+    night_single_amount,
+    night_amount
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (code) DO UPDATE SET
+    max_single_amount = EXCLUDED.max_single_amount,
+    daily_amount = EXCLUDED.daily_amount,
+    daily_count = EXCLUDED.daily_count,
+    monthly_amount = EXCLUDED.monthly_amount,
+    monthly_count = EXCLUDED.monthly_count,
+    night_single_amount = EXCLUDED.night_single_amount,
+    night_amount = EXCLUDED.night_amount,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetWalletLimits :one
+SELECT * FROM wallet_limits
+WHERE wallet_id = $1;
+
+-- name: UpsertWalletLimits :one
+INSERT INTO wallet_limits (
+    wallet_id,
+    tier_code,
+    max_single_amount,
+    daily_amount,
+    daily_count,
+    monthly_amount,
+    monthly_count,
+    night_single_amount,
+    night_amount
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (wallet_id) DO UPDATE SET
+    tier_code = EXCLUDED.tier_code,
+    max_single_amount = EXCLUDED.max_single_amount,
+    daily_amount = EXCLUDED.daily_amount,
+    daily_count = EXCLUDED.daily_count,
+    monthly_amount = EXCLUDED.monthly_amount,
+    monthly_count = EXCLUDED.monthly_count,
+    night_single_amount = EXCLUDED.night_single_amount,
+    night_amount = EXCLUDED.night_amount,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetWalletOutgoingUsage :one
+-- Saídas da carteira em cada janela de limite: toda partida de débito (transferência, perna de lançamento, saque),
+-- sem a tarifa cobrada junto na transferência. Estornos devolvem dinheiro já transferido e não consomem limite.
+SELECT
+    COALESCE(SUM(-e.amount - CASE WHEN t.from_wallet_id = e.wallet_id THEN t.fee_amount ELSE 0 END) FILTER (WHERE e.created_at >= sqlc.arg(day_start)), 0)::bigint AS daily_amount,
+    COUNT(DISTINCT e.transaction_id) FILTER (WHERE e.created_at >= sqlc.arg(day_start)) AS daily_count,
+    COALESCE(SUM(-e.amount - CASE WHEN t.from_wallet_id = e.wallet_id THEN t.fee_amount ELSE 0 END) FILTER (WHERE e.created_at >= sqlc.arg(month_start)), 0)::bigint AS monthly_amount,
+    COUNT(DISTINCT e.transaction_id) FILTER (WHERE e.created_at >= sqlc.arg(month_start)) AS monthly_count,
+    COALESCE(SUM(-e.amount - CASE WHEN t.from_wallet_id = e.wallet_id THEN t.fee_amount ELSE 0 END) FILTER (WHERE e.created_at >= sqlc.arg(night_start)), 0)::bigint AS night_amount,
+    COUNT(DISTINCT e.transaction_id) FILTER (WHERE e.created_at >= sqlc.arg(night_start)) AS night_count
+FROM entries e
+JOIN transactions t ON t.id = e.transaction_id
+WHERE e.wallet_id = sqlc.arg(wallet_id)
+  AND e.amount < 0
+  AND t.original_transaction_id IS NULL
+  AND e.created_at >= LEAST(sqlc.arg(month_start), sqlc.arg(night_start));
@@ -0,0 +1,57 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (from_wallet_id, to_wallet_id, amount, currency, execute_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1;
+
+-- name: ListScheduledTransfersByWallet :many
+SELECT * FROM scheduled_transfers
+WHERE from_wallet_id = sqlc.arg(wallet_id)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY execute_at
+LIMIT sqlc.arg(page_size);
+
+-- name: ClaimDueScheduledTransfers :many
+-- Reserva os agendamentos vencidos para este scheduler (SKIP LOCKED permite várias instâncias).
+-- Pendentes em nova tentativa esperam next_attempt_at (backoff).
+-- Itens em "processing" há mais tempo que stale_before foram abandonados por um scheduler
+-- que caiu e voltam a ser executados: a Idempotency-Key impede pagamento em dobro.
+UPDATE scheduled_transfers
+SET status = 'processing',
+    attempts = attempts + 1,
+    updated_at = NOW()
+WHERE id IN (
+    SELECT s.id FROM scheduled_transfers s
+    WHERE s.execute_at <= sqlc.arg(now)
+      AND ((s.status = 'pending' AND (s.next_attempt_at IS NULL OR s.next_attempt_at <= sqlc.arg(now)))
+           OR (s.status = 'processing' AND s.updated_at < sqlc.arg(stale_before)))
+    ORDER BY s.execute_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: FinishScheduledTransfer :execrows
+-- Grava o resultado da execução: executed, failed ou pending (nova tentativa em next_attempt_at).
+-- Só vale para a reserva feita por este scheduler: se o lease venceu e outra instância reservou
+-- de novo (attempts mudou) ou já gravou o resultado, nada é alterado.
+UPDATE scheduled_transfers
+SET status = sqlc.arg(status),
+    transaction_id = sqlc.narg(transaction_id),
+    failure_reason = sqlc.narg(failure_reason),
+    executed_at = sqlc.narg(executed_at),
+    next_attempt_at = sqlc.narg(next_attempt_at),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = 'processing'
+  AND attempts = sqlc.arg(attempts);
+
+-- name: CancelScheduledTransfer :execrows
+UPDATE scheduled_transfers
+SET status = 'cancelled',
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'pending';
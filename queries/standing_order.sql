@@ -0,0 +1,83 @@
+-- name: CreateStandingOrder :one
+INSERT INTO standing_orders (
+    from_wallet_id,
+    to_wallet_id,
+    amount,
+    currency,
+    rrule,
+    start_at,
+    insufficient_funds_policy,
+    max_retries,
+    retry_interval_seconds,
+    next_occurrence_at,
+    next_run_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: GetStandingOrder :one
+SELECT * FROM standing_orders
+WHERE id = $1;
+
+-- name: ListStandingOrdersByWallet :many
+SELECT * FROM standing_orders
+WHERE from_wallet_id = sqlc.arg(wallet_id)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: UpdateStandingOrder :exec
+-- Alterações do cliente (valor, política, pausa/retomada, cancelamento)
+UPDATE standing_orders
+SET amount = $2,
+    insufficient_funds_policy = $3,
+    max_retries = $4,
+    retry_interval_seconds = $5,
+    status = $6,
+    next_occurrence_at = $7,
+    next_run_at = $8,
+    attempts = $9,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: ClaimDueStandingOrders :many
+-- Reserva as ordens vencidas até claimed_until (SKIP LOCKED permite várias instâncias do scheduler).
+-- Se o scheduler cair, a reserva vence e outra instância reprocessa: a Idempotency-Key da ocorrência
+-- impede pagamento em dobro.
+UPDATE standing_orders
+SET claimed_until = sqlc.arg(claimed_until)
+WHERE id IN (
+    SELECT o.id FROM standing_orders o
+    WHERE o.status = 'active'
+      AND o.next_run_at <= sqlc.arg(now)
+      AND (o.claimed_until IS NULL OR o.claimed_until < sqlc.arg(now))
+    ORDER BY o.next_run_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: FinishStandingOrderRun :exec
+-- Grava o progresso do executor e libera a reserva.
+-- Se o cliente pausou ou cancelou durante a execução, o status dele prevalece.
+UPDATE standing_orders
+SET status = CASE WHEN status = 'active' THEN sqlc.arg(status) ELSE status END,
+    occurrences_count = sqlc.arg(occurrences_count),
+    next_occurrence_at = sqlc.narg(next_occurrence_at),
+    next_run_at = sqlc.narg(next_run_at),
+    attempts = sqlc.arg(attempts),
+    last_failure_reason = sqlc.narg(last_failure_reason),
+    claimed_until = NULL,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: CreateStandingOrderOccurrence :exec
+-- Reprocessar a mesma ocorrência (após queda do scheduler) não duplica o histórico
+INSERT INTO standing_order_occurrences (standing_order_id, occurrence_at, status, transaction_id, failure_reason)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (standing_order_id, occurrence_at) DO NOTHING;
+
+-- name: ListStandingOrderOccurrences :many
+SELECT * FROM standing_order_occurrences
+WHERE standing_order_id = sqlc.arg(standing_order_id)
+ORDER BY occurrence_at DESC
+LIMIT sqlc.arg(page_size);
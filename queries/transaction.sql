@@ -4,13 +4,95 @@ INSERT INTO transactions (
     to_wallet_id,
     amount,
     status,
-    idempotency_key
+    idempotency_key,
+    currency,
+    destination_amount,
+    destination_currency,
+    fx_rate,
+    fx_quote_id,
+    original_transaction_id,
+    kind,
+    description,
+    fee_amount,
+    idempotency_scope
 )
-VALUES ($1, $2, $3, $4, $5)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 RETURNING *;
 
 -- name: ListTransactions :many
+-- Extrato da carteira com paginação por cursor (keyset) sobre entries.id.
+-- Cada linha é uma partida da carteira, com o valor assinado do ponto de vista dela.
+SELECT
+    e.id AS entry_id,
+    e.amount AS signed_amount,
+    t.id,
+    t.from_wallet_id,
+    t.to_wallet_id,
+    t.amount,
+    t.status,
+    t.idempotency_key,
+    t.created_at,
+    t.currency,
+    t.kind,
+    t.fee_amount
+FROM entries e
+JOIN transactions t ON t.id = e.transaction_id
+WHERE e.wallet_id = sqlc.arg(wallet_id)
+  AND (sqlc.narg(cursor)::bigint IS NULL OR e.id < sqlc.narg(cursor))
+  AND (sqlc.narg(direction)::text IS NULL
+       OR (sqlc.narg(direction) = 'in' AND e.amount > 0)
+       OR (sqlc.narg(direction) = 'out' AND e.amount < 0))
+  AND (sqlc.narg(from_date)::timestamptz IS NULL OR t.created_at >= sqlc.narg(from_date))
+  AND (sqlc.narg(to_date)::timestamptz IS NULL OR t.created_at < sqlc.narg(to_date))
+  AND (sqlc.narg(min_amount)::bigint IS NULL OR t.amount >= sqlc.narg(min_amount))
+  AND (sqlc.narg(max_amount)::bigint IS NULL OR t.amount <= sqlc.narg(max_amount))
+  AND (sqlc.narg(status)::text IS NULL OR t.status = sqlc.narg(status))
+ORDER BY e.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListWalletStatement :many
+-- Partidas da carteira no período [since, until), da mais antiga para a mais recente (ordem do saldo corrido).
+-- LEFT JOIN: os saldos de abertura antigos (transaction_id NULL) também entram no extrato.
+SELECT
+    e.id AS entry_id,
+    e.amount AS signed_amount,
+    e.currency,
+    e.created_at,
+    t.id,
+    t.kind,
+    t.from_wallet_id,
+    t.to_wallet_id,
+    t.status,
+    t.description,
+    t.fee_amount,
+    t.original_transaction_id
+FROM entries e
+LEFT JOIN transactions t ON t.id = e.transaction_id
+WHERE e.wallet_id = sqlc.arg(wallet_id)
+  AND e.created_at >= sqlc.arg(since)
+  AND e.created_at < sqlc.arg(until)
+ORDER BY e.created_at, e.id;
+
+-- name: GetTransaction :one
+SELECT * FROM transactions
+WHERE id = $1;
+
+-- name: GetTransactionByIdempotencyKey :one
+-- A chave vale dentro do escopo (cliente e rota) que a enviou.
+-- O "IS NOT NULL" deixa explícito para o planner que pode usar o índice parcial idx_transactions_idem_key
 SELECT * FROM transactions
-WHERE from_wallet_id = $1 OR to_wallet_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
\ No newline at end of file
+WHERE idempotency_scope = $1
+  AND idempotency_key = $2
+  AND idempotency_key IS NOT NULL;
+
+-- name: GetTransactionForUpdate :one
+-- Trava a transação original: estornos concorrentes não podem ultrapassar o valor dela
+SELECT * FROM transactions
+WHERE id = $1
+FOR UPDATE;
+
+-- name: UpdateTransactionRefund :exec
+UPDATE transactions
+SET refunded_amount = $2,
+    status = $3
+WHERE id = $1;
@@ -1,12 +1,17 @@
 -- name: CreateWallet :one
-INSERT INTO wallets (balance)
-VALUES ($1)
+INSERT INTO wallets (balance, currency, customer_id)
+VALUES ($1, $2, $3)
 RETURNING *;
 
 -- name: GetWallet :one
 SELECT * FROM wallets
 WHERE id = $1;
 
+-- name: GetSystemWallet :one
+SELECT * FROM wallets
+WHERE system_code = $1
+  AND currency = $2;
+
 -- name: GetWalletForUpdate :one
 -- 🚨 CRÍTICO: "FOR UPDATE" trava a linha até o fim da transação
 SELECT * FROM wallets
@@ -22,16 +27,64 @@ WHERE id = $1;
 
 -- name: DebitWallet :execrows
 -- Retorna número de linhas afetadas. Se 0, ou saldo insuficiente ou ID errado.
+-- O saldo checado é o disponível: valores reservados por autorizações não podem ser gastos,
+-- e o limite de cheque especial permite que o saldo fique negativo até -overdraft_limit.
 UPDATE wallets
 SET balance = balance - sqlc.arg(amount),
     version = version + 1,
     updated_at = NOW()
 WHERE id = sqlc.arg(id)
-  AND balance >= sqlc.arg(amount); -- Segurança extra além do Check Constraint
+  AND (system_code IS NOT NULL OR balance - held_amount + overdraft_limit >= sqlc.arg(amount)); -- Segurança extra além do Check Constraint
 
 -- name: CreditWallet :exec
 UPDATE wallets
 SET balance = balance + sqlc.arg(amount),
     version = version + 1,
     updated_at = NOW()
-WHERE id = sqlc.arg(id);
\ No newline at end of file
+WHERE id = sqlc.arg(id);
+-- name: HoldWalletFunds :execrows
+-- Reserva saldo disponível. Se 0 linhas, saldo disponível insuficiente ou ID errado.
+UPDATE wallets
+SET held_amount = held_amount + sqlc.arg(amount),
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND balance - held_amount + overdraft_limit >= sqlc.arg(amount);
+
+-- name: ReleaseWalletFunds :execrows
+UPDATE wallets
+SET held_amount = held_amount - sqlc.arg(amount),
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND held_amount >= sqlc.arg(amount);
+
+-- name: UpdateWalletOverdraftLimit :exec
+UPDATE wallets
+SET overdraft_limit = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: CreateWalletLimitChange :one
+INSERT INTO wallet_limit_changes (wallet_id, previous_limit, new_limit, changed_by, reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListWalletLimitChanges :many
+SELECT * FROM wallet_limit_changes
+WHERE wallet_id = sqlc.arg(wallet_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: UpdateWalletStatus :exec
+UPDATE wallets
+SET status = $2,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: ListWalletsByCustomer :many
+SELECT * FROM wallets
+WHERE customer_id = $1
+ORDER BY id;
@@ -2,6 +2,8 @@
 param (
     [string]$Command = "help"
 )
+# O que vier depois do comando fica em $args (ex: .\ledger.ps1 run-interest -date 2026-01-31)
+# e é repassado aos jobs, como o "${@:2}" do ledger.sh
 
 # Configuração de Cores
 $Green = "Green"
@@ -18,6 +20,9 @@ function Show-Help {
     Write-Host "  test      " -NoNewline -ForegroundColor $Green; Write-Host " -> Roda testes unitários"
     Write-Host "  test-int  " -NoNewline -ForegroundColor $Green; Write-Host " -> Roda testes de integração"
     Write-Host "  run-api   " -NoNewline -ForegroundColor $Green; Write-Host " -> Roda a API localmente"
+    Write-Host "  run-scheduler" -NoNewline -ForegroundColor $Green; Write-Host " -> Roda o scheduler (agendamentos e expiração de holds)"
+    Write-Host "  run-interest" -NoNewline -ForegroundColor $Green; Write-Host " -> Roda o job de juros (rendimento diário e pagamento mensal)"
+    Write-Host "  run-snapshot" -NoNewline -ForegroundColor $Green; Write-Host " -> Roda o job de fotografias de saldo (saldo de fim de dia)"
 }
 
 switch ($Command) {
@@ -59,6 +64,18 @@ switch ($Command) {
         Write-Host "🔌 Iniciando API..." -ForegroundColor $Yellow
         go run cmd/api/main.go
     }
+    "run-scheduler" {
+        Write-Host "⏰ Iniciando Scheduler..." -ForegroundColor $Yellow
+        go run cmd/scheduler/main.go
+    }
+    "run-interest" {
+        Write-Host "💰 Iniciando job de juros..." -ForegroundColor $Yellow
+        go run cmd/interest/main.go @args
+    }
+    "run-snapshot" {
+        Write-Host "📸 Iniciando job de fotografias de saldo..." -ForegroundColor $Yellow
+        go run cmd/snapshot/main.go @args
+    }
     Default {
         Show-Help
     }
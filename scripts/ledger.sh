@@ -28,6 +28,9 @@ show_help() {
     echo -e "  ${GREEN}test${NC}      -> Roda testes unitários"
     echo -e "  ${GREEN}test-int${NC}  -> Roda testes de integração"
     echo -e "  ${GREEN}run-api${NC}   -> Roda a API localmente"
+    echo -e "  ${GREEN}run-scheduler${NC} -> Roda o scheduler (agendamentos e expiração de holds)"
+    echo -e "  ${GREEN}run-interest${NC}  -> Roda o job de juros (rendimento diário e pagamento mensal)"
+    echo -e "  ${GREEN}run-snapshot${NC}  -> Roda o job de fotografias de saldo (saldo de fim de dia)"
 }
 
 case "$1" in
@@ -75,6 +78,18 @@ case "$1" in
         echo -e "${YELLOW}🔌 Iniciando API...${NC}"
         cd "$PROJECT_ROOT" && go run cmd/api/main.go
         ;;
+    run-scheduler)
+        echo -e "${YELLOW}⏰ Iniciando Scheduler...${NC}"
+        cd "$PROJECT_ROOT" && go run cmd/scheduler/main.go
+        ;;
+    run-interest)
+        echo -e "${YELLOW}💰 Iniciando job de juros...${NC}"
+        cd "$PROJECT_ROOT" && go run cmd/interest/main.go "${@:2}"
+        ;;
+    run-snapshot)
+        echo -e "${YELLOW}📸 Iniciando job de fotografias de saldo...${NC}"
+        cd "$PROJECT_ROOT" && go run cmd/snapshot/main.go "${@:2}"
+        ;;
     *)
         show_help
         ;;